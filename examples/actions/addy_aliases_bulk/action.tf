@@ -0,0 +1,21 @@
+# Deactivate a leaked alias and everything else matching its description.
+action "addy_aliases_bulk" "deactivate_leaked" {
+  config {
+    operation = "deactivate"
+    filter = {
+      search = "newsletter"
+      active = true
+    }
+  }
+}
+
+# Restore aliases deleted by mistake.
+action "addy_aliases_bulk" "restore" {
+  config {
+    operation = "restore"
+    ids = [
+      "50c9e585-e7f5-41c4-9016-9014c15454bc",
+      "c549db7d-5fac-4b09-9443-9ddfc3dc0d46",
+    ]
+  }
+}
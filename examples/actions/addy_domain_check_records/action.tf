@@ -0,0 +1,6 @@
+# Run with: terraform apply -invoke=action.addy_domain_check_records.all
+action "addy_domain_check_records" "all" {
+  config {
+    domains = ["example.com", "example.org"]
+  }
+}
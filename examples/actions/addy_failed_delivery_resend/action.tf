@@ -0,0 +1,16 @@
+# Resend specific failed deliveries.
+action "addy_failed_delivery_resend" "by_id" {
+  config {
+    ids = ["8b1d4e2a-5c6f-4a7b-9d0e-1f2a3b4c5d6e"]
+  }
+}
+
+# Resend everything that bounced for a recipient since a given time.
+action "addy_failed_delivery_resend" "recipient" {
+  config {
+    filter = {
+      recipient_id  = "46eebc50-f7f8-46d7-beb9-c37f04c29a84"
+      created_after = "2025-01-01T00:00:00Z"
+    }
+  }
+}
@@ -0,0 +1,11 @@
+# Nudge every recipient that has not verified its address yet.
+action "addy_recipient_resend_verification" "all" {
+  config {}
+}
+
+# Resend only to specific recipients.
+action "addy_recipient_resend_verification" "team" {
+  config {
+    ids = ["46eebc50-f7f8-46d7-beb9-c37f04c29a84"]
+  }
+}
@@ -0,0 +1 @@
+data "addy_account_details" "current" {}
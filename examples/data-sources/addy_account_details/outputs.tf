@@ -0,0 +1,11 @@
+output "subscription" {
+  value = data.addy_account_details.current.subscription
+}
+
+output "recipient_limit" {
+  value = data.addy_account_details.current.recipient_limit
+}
+
+output "active_shared_domain_alias_limit" {
+  value = data.addy_account_details.current.active_shared_domain_alias_limit
+}
@@ -0,0 +1,8 @@
+data "addy_aliases" "unmanaged" {
+  domain  = "example.com"
+  managed = false
+}
+
+output "unmanaged_aliases" {
+  value = data.addy_aliases.unmanaged.aliases[*].email
+}
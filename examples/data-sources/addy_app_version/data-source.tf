@@ -0,0 +1 @@
+data "addy_app_version" "current" {}
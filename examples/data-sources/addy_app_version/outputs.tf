@@ -0,0 +1,7 @@
+output "version" {
+  value = data.addy_app_version.current.version
+}
+
+output "major" {
+  value = data.addy_app_version.current.major
+}
@@ -0,0 +1,7 @@
+data "addy_domain" "example" {
+  domain = "example.com"
+}
+
+output "default_recipient_id" {
+  value = data.addy_domain.example.default_recipient_id
+}
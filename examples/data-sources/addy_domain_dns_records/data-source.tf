@@ -0,0 +1,33 @@
+data "addy_domain_dns_records" "example" {
+  domain             = "example.com"
+  verification_token = var.addy_verification_token
+}
+
+variable "addy_verification_token" {
+  type      = string
+  sensitive = true
+}
+
+# Create every record in Cloudflare.
+resource "cloudflare_dns_record" "addy" {
+  for_each = { for r in data.addy_domain_dns_records.example.records : "${r.type}-${r.name}-${r.value}" => r }
+
+  zone_id  = var.cloudflare_zone_id
+  type     = each.value.type
+  name     = each.value.name
+  content  = each.value.value
+  priority = each.value.priority
+  ttl      = each.value.ttl
+}
+
+variable "cloudflare_zone_id" {
+  type = string
+}
+
+# A self-hosted instance points the records at its own mail server.
+data "addy_domain_dns_records" "self_hosted" {
+  domain      = "example.org"
+  mx_hosts    = ["mail.addy.example.net"]
+  spf_include = "addy.example.net"
+  dkim_domain = "addy.example.net"
+}
@@ -0,0 +1,11 @@
+data "addy_failed_deliveries" "hard_bounces" {
+  bounce_type   = "hard"
+  created_after = "2025-01-01T00:00:00Z"
+}
+
+check "bounces" {
+  assert {
+    condition     = length(data.addy_failed_deliveries.hard_bounces.failed_deliveries) < 10
+    error_message = "Hard bounces are spiking."
+  }
+}
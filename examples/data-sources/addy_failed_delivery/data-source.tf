@@ -0,0 +1,7 @@
+variable "failed_delivery_id" {
+  type = string
+}
+
+data "addy_failed_delivery" "example" {
+  id = var.failed_delivery_id
+}
@@ -0,0 +1 @@
+ephemeral "addy_account_details" "current" {}
@@ -0,0 +1,4 @@
+ephemeral "addy_alias" "signup" {
+  description = "Signup flow integration test"
+  on_close    = "forget"
+}
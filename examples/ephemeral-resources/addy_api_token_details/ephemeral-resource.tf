@@ -0,0 +1 @@
+ephemeral "addy_api_token_details" "current" {}
@@ -0,0 +1,7 @@
+variable "failed_delivery_id" {
+  type = string
+}
+
+ephemeral "addy_failed_delivery_content" "bounce" {
+  id = var.failed_delivery_id
+}
@@ -0,0 +1,16 @@
+list "addy_alias" "inactive" {
+  provider = addy
+
+  config {
+    active = false
+  }
+}
+
+list "addy_alias" "unmanaged" {
+  provider = addy
+
+  config {
+    domain  = "example.com"
+    managed = false
+  }
+}
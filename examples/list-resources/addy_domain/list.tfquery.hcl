@@ -0,0 +1,15 @@
+list "addy_domain" "active" {
+  provider = addy
+
+  config {
+    active = true
+  }
+}
+
+list "addy_domain" "unmanaged" {
+  provider = addy
+
+  config {
+    managed = false
+  }
+}
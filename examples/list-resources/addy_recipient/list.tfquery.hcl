@@ -0,0 +1,7 @@
+list "addy_recipient" "verified" {
+  provider = addy
+
+  config {
+    verified = true
+  }
+}
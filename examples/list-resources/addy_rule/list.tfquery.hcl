@@ -0,0 +1,11 @@
+list "addy_rule" "all" {
+  provider = addy
+}
+
+list "addy_rule" "inactive" {
+  provider = addy
+
+  config {
+    active = false
+  }
+}
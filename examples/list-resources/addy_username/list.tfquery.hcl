@@ -0,0 +1,7 @@
+list "addy_username" "unmanaged" {
+  provider = addy
+
+  config {
+    managed = false
+  }
+}
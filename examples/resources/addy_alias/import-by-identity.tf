@@ -0,0 +1,6 @@
+import {
+  to = addy_alias.shop
+  identity = {
+    email = "shop@example.com"
+  }
+}
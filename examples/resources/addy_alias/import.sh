@@ -0,0 +1,3 @@
+# Aliases can be imported by ID or by email.
+terraform import addy_alias.shop 50c9e585-e7f5-41c4-9016-9014c15454bc
+terraform import addy_alias.shop shop@example.com
@@ -0,0 +1,34 @@
+provider "addy" {
+  defaults {
+    alias_domain  = "anonaddy.me"
+    alias_format  = "random_words"
+    recipient_ids = ["46eebc50-f7f8-46d7-beb9-c37f04c29a84"]
+  }
+}
+
+# Uses the provider defaults for domain, format and recipients.
+resource "addy_alias" "newsletter" {
+  description = "Newsletter sign-ups"
+
+  # Stop forwarding on destroy but keep the address reserved.
+  deletion_mode = "deactivate"
+}
+
+resource "addy_alias" "shop" {
+  domain      = "example.com"
+  format      = "custom"
+  local_part  = "shop"
+  description = "Online shopping"
+  from_name   = "Shopping"
+  active      = true
+
+  recipient_emails = ["me@work.example.com"]
+}
+
+# Adopt the address if an earlier configuration soft deleted it.
+resource "addy_alias" "support" {
+  domain             = "example.com"
+  format             = "custom"
+  local_part         = "support"
+  restore_if_deleted = true
+}
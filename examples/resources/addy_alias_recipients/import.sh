@@ -0,0 +1,2 @@
+# Alias recipients are imported by the alias ID.
+terraform import addy_alias_recipients.support 50c9e585-e7f5-41c4-9016-9014c15454bc
@@ -0,0 +1,14 @@
+resource "addy_alias_recipients" "support" {
+  alias_id = "50c9e585-e7f5-41c4-9016-9014c15454bc"
+  recipient_ids = [
+    "46eebc50-f7f8-46d7-beb9-c37f04c29a84",
+    "b1b9a1c4-6a0c-4d0e-8b55-8a3d6e2b2f10",
+  ]
+}
+
+# Recipients can also be given by email, which keeps modules portable
+# between accounts where the recipient IDs differ.
+resource "addy_alias_recipients" "billing" {
+  alias_id         = "c549db7d-5fac-4b09-9443-9ddfc3dc0d46"
+  recipient_emails = ["billing@example.com"]
+}
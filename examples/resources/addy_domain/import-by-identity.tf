@@ -0,0 +1,6 @@
+import {
+  to = addy_domain.example
+  identity = {
+    domain = "example.com"
+  }
+}
@@ -0,0 +1,3 @@
+# Domains can be imported by ID or by domain name.
+terraform import addy_domain.example 50c9e585-e7f5-41c4-9016-9014c15454bc
+terraform import addy_domain.example example.com
@@ -0,0 +1,12 @@
+resource "addy_domain" "example" {
+  domain               = "example.com"
+  description          = "Managed domain"
+  active               = true
+  catch_all            = true
+  default_recipient_id = "46eebc50-f7f8-46d7-beb9-c37f04c29a84"
+}
+
+resource "addy_domain" "team" {
+  domain                  = "team.example.com"
+  default_recipient_email = "team@example.com"
+}
@@ -0,0 +1,31 @@
+resource "addy_domain" "example" {
+  domain = "example.com"
+}
+
+data "addy_domain_dns_records" "example" {
+  domain = addy_domain.example.id
+}
+
+resource "cloudflare_dns_record" "addy" {
+  for_each = { for r in data.addy_domain_dns_records.example.records : "${r.type}-${r.name}-${r.value}" => r }
+
+  zone_id  = var.cloudflare_zone_id
+  type     = each.value.type
+  name     = each.value.name
+  content  = each.value.value
+  priority = each.value.priority
+  ttl      = each.value.ttl
+}
+
+variable "cloudflare_zone_id" {
+  type = string
+}
+
+# Wait until the records above have propagated and verified.
+resource "addy_domain_verification" "example" {
+  domain_id      = addy_domain.example.id
+  verify_sending = true
+  timeout        = "30m"
+
+  depends_on = [cloudflare_dns_record.addy]
+}
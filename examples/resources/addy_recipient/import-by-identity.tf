@@ -0,0 +1,6 @@
+import {
+  to = addy_recipient.work
+  identity = {
+    email = "me@work.example.com"
+  }
+}
@@ -0,0 +1,3 @@
+# Recipients can be imported by ID or by email.
+terraform import addy_recipient.work 46eebc50-f7f8-46d7-beb9-c37f04c29a84
+terraform import addy_recipient.work me@work.example.com
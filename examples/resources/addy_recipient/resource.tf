@@ -0,0 +1,12 @@
+resource "addy_recipient" "work" {
+  email          = "me@work.example.com"
+  can_reply_send = true
+}
+
+# Block until the verification link has been clicked, so aliases created
+# afterwards forward straight away.
+resource "addy_recipient" "onboarding" {
+  email                 = "new.hire@work.example.com"
+  wait_for_verification = true
+  timeout               = "1h"
+}
@@ -0,0 +1,6 @@
+import {
+  to = addy_rule.newsletters
+  identity = {
+    name = "Tag newsletters"
+  }
+}
@@ -0,0 +1,3 @@
+# Rules can be imported by ID or by name, as long as no other rule shares it.
+terraform import addy_rule.newsletters 3f0e5a6c-8b1d-4e2a-9c7f-1d2b3a4c5e6f
+terraform import addy_rule.newsletters "Tag newsletters"
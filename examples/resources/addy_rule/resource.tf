@@ -0,0 +1,28 @@
+resource "addy_rule" "newsletters" {
+  name     = "Tag newsletters"
+  operator = "OR"
+
+  conditions = [
+    {
+      type   = "sender"
+      match  = "ends with"
+      values = ["@news.example.com", "@mailer.example.com"]
+    },
+    {
+      type   = "subject"
+      match  = "contains"
+      values = ["newsletter"]
+    },
+  ]
+
+  actions = [
+    {
+      type  = "subject"
+      value = "[Newsletter]"
+    },
+    {
+      type  = "banner"
+      value = "off"
+    },
+  ]
+}
@@ -0,0 +1,6 @@
+import {
+  to = addy_username.shopping
+  identity = {
+    username = "myshopping"
+  }
+}
@@ -0,0 +1,3 @@
+# Usernames can be imported by ID or by username.
+terraform import addy_username.shopping 2d9a1a45-5b4f-4d8a-9d3c-7f0e0c6b1e2a
+terraform import addy_username.shopping myshopping
@@ -0,0 +1,8 @@
+resource "addy_username" "shopping" {
+  username                = "myshopping"
+  description             = "Shopping aliases"
+  from_name               = "Shopping"
+  catch_all               = false
+  auto_create_regex       = "^order"
+  default_recipient_email = "me@work.example.com"
+}
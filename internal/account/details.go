@@ -0,0 +1,110 @@
+// Package account holds the account details model shared by the
+// addy_account_details data source and ephemeral resource.
+package account
+
+import (
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DetailsModel maps the computed account details attributes. Data sources
+// and ephemeral resources embed it next to their credential overrides.
+type DetailsModel struct {
+	ID                           types.String `tfsdk:"id"`
+	Username                     types.String `tfsdk:"username"`
+	FromName                     types.String `tfsdk:"from_name"`
+	DefaultRecipientID           types.String `tfsdk:"default_recipient_id"`
+	DefaultAliasDomain           types.String `tfsdk:"default_alias_domain"`
+	DefaultAliasFormat           types.String `tfsdk:"default_alias_format"`
+	Subscription                 types.String `tfsdk:"subscription"`
+	SubscriptionEndsAt           types.String `tfsdk:"subscription_ends_at"`
+	Bandwidth                    types.Int64  `tfsdk:"bandwidth"`
+	BandwidthLimit               types.Int64  `tfsdk:"bandwidth_limit"`
+	UsernameCount                types.Int64  `tfsdk:"username_count"`
+	UsernameLimit                types.Int64  `tfsdk:"username_limit"`
+	RecipientCount               types.Int64  `tfsdk:"recipient_count"`
+	RecipientLimit               types.Int64  `tfsdk:"recipient_limit"`
+	ActiveDomainCount            types.Int64  `tfsdk:"active_domain_count"`
+	ActiveDomainLimit            types.Int64  `tfsdk:"active_domain_limit"`
+	ActiveSharedDomainAliasCount types.Int64  `tfsdk:"active_shared_domain_alias_count"`
+	ActiveSharedDomainAliasLimit types.Int64  `tfsdk:"active_shared_domain_alias_limit"`
+	ActiveRuleCount              types.Int64  `tfsdk:"active_rule_count"`
+	ActiveRuleLimit              types.Int64  `tfsdk:"active_rule_limit"`
+	TotalAliases                 types.Int64  `tfsdk:"total_aliases"`
+	TotalActiveAliases           types.Int64  `tfsdk:"total_active_aliases"`
+	TotalInactiveAliases         types.Int64  `tfsdk:"total_inactive_aliases"`
+	TotalDeletedAliases          types.Int64  `tfsdk:"total_deleted_aliases"`
+	CreatedAt                    types.String `tfsdk:"created_at"`
+	UpdatedAt                    types.String `tfsdk:"updated_at"`
+}
+
+// Attribute describes one computed attribute of DetailsModel. The schema
+// packages differ per Terraform object kind, so each builds its own schema
+// attributes from these.
+type Attribute struct {
+	Description string
+	Int64       bool
+}
+
+// Attributes describes every attribute of DetailsModel by name.
+var Attributes = map[string]Attribute{
+	"id":                               {Description: "The ID of the account."},
+	"username":                         {Description: "The username of the account."},
+	"from_name":                        {Description: "The default from name. Null if not set."},
+	"default_recipient_id":             {Description: "The ID of the default recipient."},
+	"default_alias_domain":             {Description: "The default domain for new aliases."},
+	"default_alias_format":             {Description: "The default format for new aliases."},
+	"subscription":                     {Description: "The subscription plan of the account. Null on self-hosted instances."},
+	"subscription_ends_at":             {Description: "The timestamp the subscription ends. Null if it does not end."},
+	"bandwidth":                        {Description: "The bandwidth used this month, in bytes.", Int64: true},
+	"bandwidth_limit":                  {Description: "The monthly bandwidth limit, in bytes. Null if unlimited.", Int64: true},
+	"username_count":                   {Description: "The number of usernames.", Int64: true},
+	"username_limit":                   {Description: "The maximum number of usernames. Null if unlimited.", Int64: true},
+	"recipient_count":                  {Description: "The number of recipients.", Int64: true},
+	"recipient_limit":                  {Description: "The maximum number of recipients. Null if unlimited.", Int64: true},
+	"active_domain_count":              {Description: "The number of active custom domains.", Int64: true},
+	"active_domain_limit":              {Description: "The maximum number of active custom domains. Null if unlimited.", Int64: true},
+	"active_shared_domain_alias_count": {Description: "The number of active aliases on shared domains.", Int64: true},
+	"active_shared_domain_alias_limit": {Description: "The maximum number of active aliases on shared domains. Null if unlimited.", Int64: true},
+	"active_rule_count":                {Description: "The number of active rules.", Int64: true},
+	"active_rule_limit":                {Description: "The maximum number of active rules. Null if unlimited.", Int64: true},
+	"total_aliases":                    {Description: "The total number of aliases.", Int64: true},
+	"total_active_aliases":             {Description: "The number of active aliases.", Int64: true},
+	"total_inactive_aliases":           {Description: "The number of inactive aliases.", Int64: true},
+	"total_deleted_aliases":            {Description: "The number of deleted aliases.", Int64: true},
+	"created_at":                       {Description: "The creation timestamp of the account."},
+	"updated_at":                       {Description: "The last update timestamp of the account."},
+}
+
+// NewDetailsModel maps an account-details response to its Terraform model.
+func NewDetailsModel(details *utils.AccountDetails) DetailsModel {
+	return DetailsModel{
+		ID:                           types.StringValue(details.ID),
+		Username:                     types.StringValue(details.Username),
+		FromName:                     types.StringPointerValue(details.FromName),
+		DefaultRecipientID:           types.StringValue(details.DefaultRecipientID),
+		DefaultAliasDomain:           types.StringValue(details.DefaultAliasDomain),
+		DefaultAliasFormat:           types.StringValue(details.DefaultAliasFormat),
+		Subscription:                 types.StringPointerValue(details.Subscription),
+		SubscriptionEndsAt:           types.StringPointerValue(details.SubscriptionEndsAt),
+		Bandwidth:                    types.Int64Value(details.Bandwidth),
+		BandwidthLimit:               types.Int64PointerValue(details.BandwidthLimit),
+		UsernameCount:                types.Int64Value(details.UsernameCount),
+		UsernameLimit:                types.Int64PointerValue(details.UsernameLimit),
+		RecipientCount:               types.Int64Value(details.RecipientCount),
+		RecipientLimit:               types.Int64PointerValue(details.RecipientLimit),
+		ActiveDomainCount:            types.Int64Value(details.ActiveDomainCount),
+		ActiveDomainLimit:            types.Int64PointerValue(details.ActiveDomainLimit),
+		ActiveSharedDomainAliasCount: types.Int64Value(details.ActiveSharedDomainAliasCount),
+		ActiveSharedDomainAliasLimit: types.Int64PointerValue(details.ActiveSharedDomainAliasLimit),
+		ActiveRuleCount:              types.Int64Value(details.ActiveRuleCount),
+		ActiveRuleLimit:              types.Int64PointerValue(details.ActiveRuleLimit),
+		TotalAliases:                 types.Int64Value(details.TotalAliases),
+		TotalActiveAliases:           types.Int64Value(details.TotalActiveAliases),
+		TotalInactiveAliases:         types.Int64Value(details.TotalInactiveAliases),
+		TotalDeletedAliases:          types.Int64Value(details.TotalDeletedAliases),
+		CreatedAt:                    types.StringValue(details.CreatedAt),
+		UpdatedAt:                    types.StringValue(details.UpdatedAt),
+	}
+}
@@ -0,0 +1,45 @@
+package action
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ActionData struct {
+	Sessions *utils.Sessions
+}
+
+// credentialsModel maps the optional per-action credential overrides.
+type credentialsModel struct {
+	ApiKey  types.String `tfsdk:"api_key"`
+	BaseURL types.String `tfsdk:"base_url"`
+}
+
+// session returns the session for the action's credential overrides,
+// falling back to the provider configuration.
+func (p *ActionData) session(ctx context.Context, creds credentialsModel) (*utils.Session, error) {
+	if creds.ApiKey.IsUnknown() || creds.BaseURL.IsUnknown() {
+		return nil, fmt.Errorf("the api_key and base_url overrides must be known to reach the Addy API")
+	}
+	return p.Sessions.Resolve(ctx, creds.ApiKey.ValueString(), creds.BaseURL.ValueString())
+}
+
+// withCredentials adds the api_key and base_url override attributes to an
+// action schema.
+func withCredentials(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["api_key"] = schema.StringAttribute{
+		MarkdownDescription: "API key used instead of the provider's for this action. " +
+			"Action configuration is never stored in state; pass the key from a variable or ephemeral value.",
+		Optional: true,
+	}
+	attributes["base_url"] = schema.StringAttribute{
+		MarkdownDescription: "Base URL of the Addy instance used instead of the provider's for this action.",
+		Optional:            true,
+	}
+	return attributes
+}
@@ -0,0 +1,242 @@
+package action
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action                   = &aliasesBulkAction{}
+	_ action.ActionWithConfigure      = &aliasesBulkAction{}
+	_ action.ActionWithValidateConfig = &aliasesBulkAction{}
+)
+
+// NewAliasesBulkAction is a helper function to simplify the provider implementation.
+func NewAliasesBulkAction() action.Action {
+	return &aliasesBulkAction{}
+}
+
+// aliasesBulkAction applies one bulk operation to aliases given by ID or filter.
+type aliasesBulkAction struct {
+	providerData *ActionData
+}
+
+type aliasesBulkModel struct {
+	credentialsModel
+	Operation types.String      `tfsdk:"operation"`
+	IDs       types.List        `tfsdk:"ids"`
+	Filter    *aliasFilterModel `tfsdk:"filter"`
+}
+
+type aliasFilterModel struct {
+	Search  types.String `tfsdk:"search"`
+	Domain  types.String `tfsdk:"domain"`
+	Active  types.Bool   `tfsdk:"active"`
+	Deleted types.String `tfsdk:"deleted"`
+}
+
+// aliasDeletedFilters lists the values accepted by the deleted filter.
+var aliasDeletedFilters = []string{"with", "only"}
+
+// Metadata returns the action type name.
+func (a *aliasesBulkAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_aliases_bulk"
+}
+
+// Schema defines the schema for the action.
+func (a *aliasesBulkAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Activates, deactivates, deletes, forgets or restores aliases in bulk. " +
+			fmt.Sprintf("Requests are sent in batches of %d, the API's bulk limit.", utils.AliasBulkLimit),
+
+		Attributes: withCredentials(map[string]schema.Attribute{
+			"operation": schema.StringAttribute{
+				MarkdownDescription: "Operation to apply. One of `" + strings.Join(utils.AliasBulkOperations, "`, `") + "`.",
+				Required:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the aliases to operate on. Conflicts with `filter`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				MarkdownDescription: "Operate on every alias matching these fields. At least one of `search`, `domain` " +
+					"or `active` must be set. Conflicts with `ids`.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"search": schema.StringAttribute{
+						MarkdownDescription: "Only aliases whose email or description contains this text.",
+						Optional:            true,
+					},
+					"domain": schema.StringAttribute{
+						MarkdownDescription: "Only aliases on this domain.",
+						Optional:            true,
+					},
+					"active": schema.BoolAttribute{
+						MarkdownDescription: "Only active or only inactive aliases.",
+						Optional:            true,
+					},
+					"deleted": schema.StringAttribute{
+						MarkdownDescription: "`with` to include soft-deleted aliases or `only` to select nothing else. " +
+							"Defaults to `only` for the `restore` operation.",
+						Optional: true,
+					},
+				},
+			},
+		}),
+	}
+}
+
+// ValidateConfig checks the operation and requires exactly one of ids and
+// filter. A filter must narrow the selection so an empty one cannot operate
+// on every alias in the account.
+func (a *aliasesBulkAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config aliasesBulkModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Operation.IsUnknown() && !slices.Contains(utils.AliasBulkOperations, config.Operation.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("operation"),
+			"Invalid Bulk Operation",
+			"The operation must be one of "+strings.Join(utils.AliasBulkOperations, ", ")+", got: "+config.Operation.ValueString(),
+		)
+	}
+
+	if config.IDs.IsNull() == (config.Filter == nil) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ids"),
+			"Invalid Alias Selection",
+			"Exactly one of ids or filter must be set.",
+		)
+	}
+
+	if config.Filter != nil && config.Filter.Search.IsNull() && config.Filter.Domain.IsNull() && config.Filter.Active.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("filter"),
+			"Invalid Alias Filter",
+			"At least one of search, domain or active must be set in filter.",
+		)
+	}
+
+	if config.Filter != nil && !config.Filter.Deleted.IsNull() && !config.Filter.Deleted.IsUnknown() &&
+		!slices.Contains(aliasDeletedFilters, config.Filter.Deleted.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("filter").AtName("deleted"),
+			"Invalid Deleted Filter",
+			"The deleted filter must be one of "+strings.Join(aliasDeletedFilters, ", ")+", got: "+config.Filter.Deleted.ValueString(),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the action.
+func (a *aliasesBulkAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ActionData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *ActionData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.providerData = providerData
+}
+
+// Invoke applies the operation batch by batch, reporting progress per batch.
+func (a *aliasesBulkAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config aliasesBulkModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := a.providerData.session(ctx, config.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+	operation := config.Operation.ValueString()
+
+	var ids []string
+	if config.Filter != nil {
+		filter := utils.AliasFilter{
+			Search:  config.Filter.Search.ValueString(),
+			Deleted: config.Filter.Deleted.ValueString(),
+		}
+		if !config.Filter.Active.IsNull() {
+			active := config.Filter.Active.ValueBool()
+			filter.Active = &active
+		}
+		if filter.Deleted == "" && operation == "restore" {
+			filter.Deleted = "only"
+		}
+
+		aliases, err := utils.ListAliases(ctx, session, filter)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to List Aliases", err.Error())
+			return
+		}
+		for _, alias := range aliases {
+			if domain := config.Filter.Domain.ValueString(); domain != "" && alias.Domain != domain {
+				continue
+			}
+			ids = append(ids, alias.ID)
+		}
+	} else {
+		resp.Diagnostics.Append(config.IDs.ElementsAs(ctx, &ids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Applying bulk alias operation", map[string]interface{}{
+		"operation": operation,
+		"count":     len(ids),
+	})
+
+	processed := 0
+	for batch := range slices.Chunk(ids, utils.AliasBulkLimit) {
+		done, err := utils.BulkAliases(ctx, session, operation, batch)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Apply Bulk Alias Operation",
+				fmt.Sprintf("Bulk %s of %s: %s", operation, strings.Join(batch, ", "), err.Error()),
+			)
+			continue
+		}
+		processed += len(done)
+
+		if skipped := len(batch) - len(done); skipped > 0 {
+			resp.Diagnostics.AddWarning(
+				"Aliases Not Processed",
+				fmt.Sprintf("The API skipped %d of %d aliases in a bulk %s batch.", skipped, len(batch), operation),
+			)
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("%s: %d of %d aliases processed", operation, processed, len(ids)),
+		})
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Bulk %s finished: %d processed, %d not processed", operation, processed, len(ids)-processed),
+	})
+}
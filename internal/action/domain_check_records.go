@@ -0,0 +1,131 @@
+package action
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &domainCheckRecordsAction{}
+	_ action.ActionWithConfigure = &domainCheckRecordsAction{}
+)
+
+// NewDomainCheckRecordsAction is a helper function to simplify the provider implementation.
+func NewDomainCheckRecordsAction() action.Action {
+	return &domainCheckRecordsAction{}
+}
+
+// domainCheckRecordsAction triggers the sending record check of custom domains.
+type domainCheckRecordsAction struct {
+	providerData *ActionData
+}
+
+type domainCheckRecordsModel struct {
+	credentialsModel
+	Domains types.List `tfsdk:"domains"`
+}
+
+// Metadata returns the action type name.
+func (a *domainCheckRecordsAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_check_records"
+}
+
+// Schema defines the schema for the action.
+func (a *domainCheckRecordsAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Triggers the server-side check of the SPF, DKIM and DMARC records that verify custom domains " +
+			"for sending, and reports the outcome. Failed checks are reported as warnings. The verification and " +
+			"MX records for receiving are checked by Addy on its own schedule; the API cannot trigger that check.",
+
+		Attributes: withCredentials(map[string]schema.Attribute{
+			"domains": schema.ListAttribute{
+				MarkdownDescription: "The domains to check, by ID or name.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+		}),
+	}
+}
+
+// Configure adds the provider configured client to the action.
+func (a *domainCheckRecordsAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ActionData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *ActionData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.providerData = providerData
+}
+
+// Invoke checks each domain in turn, reporting the outcome per domain.
+func (a *domainCheckRecordsAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config domainCheckRecordsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var keys []string
+	resp.Diagnostics.Append(config.Domains.ElementsAs(ctx, &keys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := a.providerData.session(ctx, config.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	for i, key := range keys {
+		domain, err := utils.FindDomain(ctx, session, key)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Domain",
+				fmt.Sprintf("Domain %s: %s", key, err.Error()),
+			)
+			continue
+		}
+
+		tflog.Debug(ctx, "Checking domain sending records", map[string]interface{}{
+			"id":     domain.ID,
+			"domain": domain.Domain,
+		})
+
+		check, err := utils.CheckDomainSending(ctx, session, domain.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Check Domain Sending Records",
+				fmt.Sprintf("Domain %s: %s", domain.Domain, err.Error()),
+			)
+			continue
+		}
+
+		if !check.Success {
+			resp.Diagnostics.AddWarning(
+				"Domain Not Verified for Sending",
+				fmt.Sprintf("Domain %s: %s", domain.Domain, check.Message),
+			)
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("[%d/%d] %s: %s", i+1, len(keys), domain.Domain, check.Message),
+		})
+	}
+}
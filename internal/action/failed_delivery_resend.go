@@ -0,0 +1,213 @@
+package action
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action                   = &failedDeliveryResendAction{}
+	_ action.ActionWithConfigure      = &failedDeliveryResendAction{}
+	_ action.ActionWithValidateConfig = &failedDeliveryResendAction{}
+)
+
+// NewFailedDeliveryResendAction is a helper function to simplify the provider implementation.
+func NewFailedDeliveryResendAction() action.Action {
+	return &failedDeliveryResendAction{}
+}
+
+// failedDeliveryResendAction resends failed deliveries given by ID or filter.
+type failedDeliveryResendAction struct {
+	providerData *ActionData
+}
+
+type failedDeliveryResendModel struct {
+	credentialsModel
+	IDs    types.List                 `tfsdk:"ids"`
+	Filter *failedDeliveryFilterModel `tfsdk:"filter"`
+}
+
+type failedDeliveryFilterModel struct {
+	AliasID      types.String `tfsdk:"alias_id"`
+	RecipientID  types.String `tfsdk:"recipient_id"`
+	BounceType   types.String `tfsdk:"bounce_type"`
+	CreatedAfter types.String `tfsdk:"created_after"`
+}
+
+// Metadata returns the action type name.
+func (a *failedDeliveryResendAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_failed_delivery_resend"
+}
+
+// Schema defines the schema for the action.
+func (a *failedDeliveryResendAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resends failed deliveries, given by ID or selected with a filter. " +
+			"Every delivery is attempted; failures are reported together at the end.",
+
+		Attributes: withCredentials(map[string]schema.Attribute{
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the failed deliveries to resend. Conflicts with `filter`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				MarkdownDescription: "Resend every failed delivery matching these fields. At least one of `alias_id`, " +
+					"`recipient_id`, `bounce_type` or `created_after` must be set. Conflicts with `ids`.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"alias_id": schema.StringAttribute{
+						MarkdownDescription: "Only resend failed deliveries for this alias.",
+						Optional:            true,
+					},
+					"recipient_id": schema.StringAttribute{
+						MarkdownDescription: "Only resend failed deliveries for this recipient.",
+						Optional:            true,
+					},
+					"bounce_type": schema.StringAttribute{
+						MarkdownDescription: "Only resend failed deliveries with this bounce type.",
+						Optional:            true,
+					},
+					"created_after": schema.StringAttribute{
+						MarkdownDescription: "Only resend failed deliveries recorded after this RFC 3339 timestamp.",
+						Optional:            true,
+					},
+				},
+			},
+		}),
+	}
+}
+
+// ValidateConfig requires exactly one of ids and filter. A filter must narrow
+// the selection so an empty one cannot resend every failed delivery.
+func (a *failedDeliveryResendAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config failedDeliveryResendModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.IDs.IsNull() == (config.Filter == nil) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ids"),
+			"Invalid Failed Delivery Selection",
+			"Exactly one of ids or filter must be set.",
+		)
+	}
+
+	if config.Filter != nil && config.Filter.AliasID.IsNull() && config.Filter.RecipientID.IsNull() &&
+		config.Filter.BounceType.IsNull() && config.Filter.CreatedAfter.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("filter"),
+			"Invalid Failed Delivery Filter",
+			"At least one of alias_id, recipient_id, bounce_type or created_after must be set in filter.",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the action.
+func (a *failedDeliveryResendAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ActionData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *ActionData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.providerData = providerData
+}
+
+// Invoke resends each selected failed delivery, reporting progress per item.
+func (a *failedDeliveryResendAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config failedDeliveryResendModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := a.providerData.session(ctx, config.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	if err := session.Version.Require(ctx, utils.FeatureFailedDeliveryResend); err != nil {
+		resp.Diagnostics.AddError("Unsupported Addy Version", err.Error())
+		return
+	}
+
+	var ids []string
+	if config.Filter != nil {
+		filter := utils.FailedDeliveryFilter{
+			AliasID:     config.Filter.AliasID.ValueString(),
+			RecipientID: config.Filter.RecipientID.ValueString(),
+			BounceType:  config.Filter.BounceType.ValueString(),
+		}
+		if !config.Filter.CreatedAfter.IsNull() {
+			createdAfter, err := utils.ParseTimestamp(config.Filter.CreatedAfter.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("filter").AtName("created_after"), "Invalid Created After Timestamp", err.Error())
+				return
+			}
+			filter.CreatedAfter = &createdAfter
+		}
+
+		deliveries, err := utils.ListFailedDeliveries(ctx, session)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to List Failed Deliveries", err.Error())
+			return
+		}
+		for _, delivery := range deliveries {
+			if filter.Matches(&delivery) {
+				ids = append(ids, delivery.ID)
+			}
+		}
+	} else {
+		resp.Diagnostics.Append(config.IDs.ElementsAs(ctx, &ids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Resending failed deliveries", map[string]interface{}{
+		"count": len(ids),
+	})
+
+	failed := 0
+	for i, id := range ids {
+		if err := utils.ResendFailedDelivery(ctx, session, id); err != nil {
+			failed++
+			resp.Diagnostics.AddError(
+				"Unable to Resend Failed Delivery",
+				fmt.Sprintf("Failed delivery %s: %s", id, err.Error()),
+			)
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("[%d/%d] failed to resend %s", i+1, len(ids), id),
+			})
+			continue
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("[%d/%d] resent %s", i+1, len(ids), id),
+		})
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Resent %d of %d failed deliveries", len(ids)-failed, len(ids)),
+	})
+}
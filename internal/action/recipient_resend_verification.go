@@ -0,0 +1,153 @@
+package action
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &recipientResendVerificationAction{}
+	_ action.ActionWithConfigure = &recipientResendVerificationAction{}
+)
+
+// NewRecipientResendVerificationAction is a helper function to simplify the provider implementation.
+func NewRecipientResendVerificationAction() action.Action {
+	return &recipientResendVerificationAction{}
+}
+
+// recipientResendVerificationAction resends verification emails to recipients.
+type recipientResendVerificationAction struct {
+	providerData *ActionData
+}
+
+type recipientResendVerificationModel struct {
+	credentialsModel
+	IDs types.List `tfsdk:"ids"`
+}
+
+// Metadata returns the action type name.
+func (a *recipientResendVerificationAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_recipient_resend_verification"
+}
+
+// Schema defines the schema for the action.
+func (a *recipientResendVerificationAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resends the verification email to recipients that have not verified their address. " +
+			"Already verified recipients are skipped. To block until a new recipient is verified, set " +
+			"`wait_for_verification` on the `addy_recipient` resource instead.",
+
+		Attributes: withCredentials(map[string]schema.Attribute{
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the recipients. When omitted, every unverified recipient is sent a new email.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		}),
+	}
+}
+
+// Configure adds the provider configured client to the action.
+func (a *recipientResendVerificationAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ActionData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *ActionData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.providerData = providerData
+}
+
+// Invoke resends the verification email to each selected recipient.
+func (a *recipientResendVerificationAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config recipientResendVerificationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := a.providerData.session(ctx, config.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	var recipients []utils.Recipient
+	if config.IDs.IsNull() {
+		all, err := utils.ListRecipients(ctx, session)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to List Recipients", err.Error())
+			return
+		}
+		for _, recipient := range all {
+			if !recipient.Verified() {
+				recipients = append(recipients, recipient)
+			}
+		}
+	} else {
+		var ids []string
+		resp.Diagnostics.Append(config.IDs.ElementsAs(ctx, &ids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, id := range ids {
+			recipient, err := utils.GetRecipient(ctx, session, id)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Read Recipient",
+					fmt.Sprintf("Recipient %s: %s", id, err.Error()),
+				)
+				continue
+			}
+			if recipient.Verified() {
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("%s is already verified, skipping", recipient.Email),
+				})
+				continue
+			}
+			recipients = append(recipients, *recipient)
+		}
+	}
+
+	tflog.Debug(ctx, "Resending recipient verification emails", map[string]interface{}{
+		"count": len(recipients),
+	})
+
+	sent := 0
+	for i, recipient := range recipients {
+		if err := utils.ResendRecipientVerification(ctx, session, recipient.ID); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Resend Verification Email",
+				fmt.Sprintf("Recipient %s: %s", recipient.Email, err.Error()),
+			)
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("[%d/%d] failed to resend verification to %s", i+1, len(recipients), recipient.Email),
+			})
+			continue
+		}
+
+		sent++
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("[%d/%d] resent verification to %s", i+1, len(recipients), recipient.Email),
+		})
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Resent %d of %d verification emails", sent, len(recipients)),
+	})
+}
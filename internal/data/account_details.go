@@ -0,0 +1,118 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/account"
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &accountDetailsDataSource{}
+	_ datasource.DataSourceWithConfigure = &accountDetailsDataSource{}
+)
+
+func NewAccountDetailsDataSource() datasource.DataSource {
+	return &accountDetailsDataSource{}
+}
+
+type accountDetailsDataSource struct {
+	providerData *DataSourceData
+}
+
+type accountDetailsModel struct {
+	credentialsModel
+	account.DetailsModel
+}
+
+func (d *accountDetailsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_details"
+}
+
+func (d *accountDetailsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the subscription, bandwidth, limits and counts of the current account.",
+
+		Attributes: withCredentials(accountDetailsAttributes()),
+	}
+}
+
+func (d *accountDetailsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DataSourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *DataSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *accountDetailsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config accountDetailsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading account details")
+
+	session, err := d.providerData.session(ctx, config.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Addy API Session",
+			err.Error(),
+		)
+		return
+	}
+
+	details, err := utils.GetAccountDetails(ctx, session)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Account Details",
+			err.Error(),
+		)
+		return
+	}
+
+	state := accountDetailsModel{DetailsModel: account.NewDetailsModel(details)}
+	state.credentialsModel = config.credentialsModel
+
+	tflog.Debug(ctx, "Account details read successfully", map[string]interface{}{
+		"username":     details.Username,
+		"subscription": details.Subscription,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// accountDetailsAttributes builds the data source schema attributes for
+// account.DetailsModel.
+func accountDetailsAttributes() map[string]schema.Attribute {
+	attributes := make(map[string]schema.Attribute, len(account.Attributes))
+	for name, attribute := range account.Attributes {
+		if attribute.Int64 {
+			attributes[name] = schema.Int64Attribute{
+				MarkdownDescription: attribute.Description,
+				Computed:            true,
+			}
+			continue
+		}
+		attributes[name] = schema.StringAttribute{
+			MarkdownDescription: attribute.Description,
+			Computed:            true,
+		}
+	}
+	return attributes
+}
@@ -2,23 +2,60 @@ package data
 
 import (
 	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource = &aliasesDataSource{}
+	_ datasource.DataSource                   = &aliasesDataSource{}
+	_ datasource.DataSourceWithConfigure      = &aliasesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &aliasesDataSource{}
 )
 
-// NewaliasesDataSource is a helper function to simplify the provider implementation.
+// NewAliasesDataSource is a helper function to simplify the provider implementation.
 func NewAliasesDataSource() datasource.DataSource {
 	return &aliasesDataSource{}
 }
 
 // aliasesDataSource is the data source implementation.
-type aliasesDataSource struct{}
+type aliasesDataSource struct {
+	providerData *DataSourceData
+}
+
+type aliasesDataSourceModel struct {
+	credentialsModel
+	ID      types.String `tfsdk:"id"`
+	Search  types.String `tfsdk:"search"`
+	Domain  types.String `tfsdk:"domain"`
+	Active  types.Bool   `tfsdk:"active"`
+	Deleted types.String `tfsdk:"deleted"`
+	Managed types.Bool   `tfsdk:"managed"`
+	Aliases []aliasModel `tfsdk:"aliases"`
+}
+
+// aliasModel maps an alias entry of addy_aliases to a Go type.
+type aliasModel struct {
+	ID           types.String   `tfsdk:"id"`
+	Email        types.String   `tfsdk:"email"`
+	LocalPart    types.String   `tfsdk:"local_part"`
+	Domain       types.String   `tfsdk:"domain"`
+	Description  types.String   `tfsdk:"description"`
+	Managed      types.Bool     `tfsdk:"managed"`
+	FromName     types.String   `tfsdk:"from_name"`
+	RecipientIds []types.String `tfsdk:"recipient_ids"`
+	Active       types.Bool     `tfsdk:"active"`
+	CreatedAt    types.String   `tfsdk:"created_at"`
+	DeletedAt    types.String   `tfsdk:"deleted_at"`
+}
 
 // Metadata returns the data source type name.
 func (d *aliasesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -27,10 +64,208 @@ func (d *aliasesDataSource) Metadata(_ context.Context, req datasource.MetadataR
 
 // Schema defines the schema for the data source.
 func (d *aliasesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists aliases, optionally filtered by search term, domain, status and whether they are managed by Terraform.",
+
+		Attributes: withCredentials(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Placeholder identifier attribute.",
+				Computed:            true,
+			},
+			"search": schema.StringAttribute{
+				MarkdownDescription: "Only list aliases whose email or description contains this term.",
+				Optional:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Only list aliases on this domain.",
+				Optional:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Only list aliases that are active (`true`) or inactive (`false`).",
+				Optional:            true,
+			},
+			"deleted": schema.StringAttribute{
+				MarkdownDescription: "Set to `with` to include deleted aliases or `only` to list nothing else.",
+				Optional:            true,
+			},
+			"managed": schema.BoolAttribute{
+				MarkdownDescription: "Only list aliases that carry (`true`) or lack (`false`) the provider's managed marker.",
+				Optional:            true,
+			},
+			"aliases": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching aliases.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: aliasAttributes(),
+				},
+			},
+		}),
+	}
+}
+
+// aliasAttributes returns the computed attributes of an alias entry.
+func aliasAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the alias.",
+			Computed:            true,
+		},
+		"email": schema.StringAttribute{
+			MarkdownDescription: "The email address of the alias.",
+			Computed:            true,
+		},
+		"local_part": schema.StringAttribute{
+			MarkdownDescription: "The local part of the alias.",
+			Computed:            true,
+		},
+		"domain": schema.StringAttribute{
+			MarkdownDescription: "The domain of the alias.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the alias, without the managed marker.",
+			Computed:            true,
+		},
+		"managed": schema.BoolAttribute{
+			MarkdownDescription: "Whether the description carries the provider's managed marker.",
+			Computed:            true,
+		},
+		"from_name": schema.StringAttribute{
+			MarkdownDescription: "The from name used when replying from the alias.",
+			Computed:            true,
+		},
+		"recipient_ids": schema.ListAttribute{
+			MarkdownDescription: "IDs of the recipients the alias forwards to. Empty if it uses the default recipient.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"active": schema.BoolAttribute{
+			MarkdownDescription: "Whether the alias forwards mail.",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "The creation timestamp of the alias.",
+			Computed:            true,
+		},
+		"deleted_at": schema.StringAttribute{
+			MarkdownDescription: "When the alias was deleted. Null if it is not deleted.",
+			Computed:            true,
+		},
+	}
+}
+
+// ValidateConfig checks the deleted filter.
+func (d *aliasesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config aliasesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if deleted := config.Deleted.ValueString(); deleted != "" && deleted != "with" && deleted != "only" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deleted"),
+			"Invalid Deleted Filter",
+			"deleted must be with or only, got: "+deleted,
+		)
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *aliasesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DataSourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *DataSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *aliasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	panic("not implemented")
+	var state aliasesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := utils.AliasFilter{
+		Search:  state.Search.ValueString(),
+		Active:  state.Active.ValueBoolPointer(),
+		Deleted: state.Deleted.ValueString(),
+	}
+
+	tflog.Debug(ctx, "Listing aliases")
+
+	session, err := d.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Addy API Session",
+			err.Error(),
+		)
+		return
+	}
+
+	aliases, err := utils.ListAliases(ctx, session, filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Aliases",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Aliases = []aliasModel{}
+	for _, alias := range aliases {
+		if !state.Domain.IsNull() && !strings.EqualFold(state.Domain.ValueString(), alias.Domain) {
+			continue
+		}
+		model := newAliasModel(&alias, d.providerData.ManagedMarker)
+		if !state.Managed.IsNull() && state.Managed.ValueBool() != model.Managed.ValueBool() {
+			continue
+		}
+		state.Aliases = append(state.Aliases, model)
+	}
+	state.ID = types.StringValue("aliases")
+
+	tflog.Debug(ctx, "Aliases listed successfully", map[string]interface{}{
+		"total":   len(aliases),
+		"matched": len(state.Aliases),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func newAliasModel(alias *utils.Alias, marker string) aliasModel {
+	description := ""
+	if alias.Description != nil {
+		description = *alias.Description
+	}
+
+	recipientIds := make([]types.String, 0, len(alias.Recipients))
+	for _, recipient := range alias.Recipients {
+		recipientIds = append(recipientIds, types.StringValue(recipient.ID))
+	}
+
+	return aliasModel{
+		ID:           types.StringValue(alias.ID),
+		Email:        types.StringValue(alias.Email),
+		LocalPart:    types.StringValue(alias.LocalPart),
+		Domain:       types.StringValue(alias.Domain),
+		Description:  types.StringValue(utils.StripMarker(description, marker)),
+		Managed:      types.BoolValue(utils.HasMarker(description, marker)),
+		FromName:     types.StringPointerValue(alias.FromName),
+		RecipientIds: recipientIds,
+		Active:       types.BoolValue(alias.Active),
+		CreatedAt:    types.StringValue(alias.CreatedAt),
+		DeletedAt:    types.StringPointerValue(alias.DeletedAt),
+	}
 }
@@ -2,14 +2,18 @@ package data
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource = &appVersionDataSource{}
+	_ datasource.DataSource              = &appVersionDataSource{}
+	_ datasource.DataSourceWithConfigure = &appVersionDataSource{}
 )
 
 // NewappVersionDataSource is a helper function to simplify the provider implementation.
@@ -18,19 +22,105 @@ func NewAppVersionDataSource() datasource.DataSource {
 }
 
 // appVersionDataSource is the data source implementation.
-type appVersionDataSource struct{}
+type appVersionDataSource struct {
+	providerData *DataSourceData
+}
+
+type appVersionModel struct {
+	credentialsModel
+	ID      types.String `tfsdk:"id"`
+	Version types.String `tfsdk:"version"`
+	Major   types.Int64  `tfsdk:"major"`
+	Minor   types.Int64  `tfsdk:"minor"`
+	Patch   types.Int64  `tfsdk:"patch"`
+}
 
 // Metadata returns the data source type name.
 func (d *appVersionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_appVersion"
+	resp.TypeName = req.ProviderTypeName + "_app_version"
 }
 
 // Schema defines the schema for the data source.
 func (d *appVersionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the version of the Addy server, useful for self-hosted instances.",
+
+		Attributes: withCredentials(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Placeholder identifier attribute.",
+				Computed:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The full version string reported by the server.",
+				Computed:            true,
+			},
+			"major": schema.Int64Attribute{
+				MarkdownDescription: "The major version.",
+				Computed:            true,
+			},
+			"minor": schema.Int64Attribute{
+				MarkdownDescription: "The minor version.",
+				Computed:            true,
+			},
+			"patch": schema.Int64Attribute{
+				MarkdownDescription: "The patch version.",
+				Computed:            true,
+			},
+		}),
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *appVersionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DataSourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *DataSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *appVersionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	panic("not implemented")
+	var state appVersionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading app version")
+
+	session, err := d.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Addy API Session",
+			err.Error(),
+		)
+		return
+	}
+
+	version, err := session.Version.Version(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read App Version",
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue("app-version")
+	state.Version = types.StringValue(version.Version)
+	state.Major = types.Int64Value(version.Major)
+	state.Minor = types.Int64Value(version.Minor)
+	state.Patch = types.Int64Value(version.Patch)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
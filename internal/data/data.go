@@ -1,10 +1,46 @@
 package data
 
 import (
-	"net/http"
+	"context"
+	"fmt"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type DataSourceData struct {
-	Client *http.Client
-	ApiKey string
-}
\ No newline at end of file
+	Sessions      *utils.Sessions
+	ManagedMarker string
+}
+
+// credentialsModel maps the optional per-data-source credential overrides.
+type credentialsModel struct {
+	ApiKey  types.String `tfsdk:"api_key"`
+	BaseURL types.String `tfsdk:"base_url"`
+}
+
+// session returns the session for the data source's credential overrides,
+// falling back to the provider configuration.
+func (p *DataSourceData) session(ctx context.Context, creds credentialsModel) (*utils.Session, error) {
+	if creds.ApiKey.IsUnknown() || creds.BaseURL.IsUnknown() {
+		return nil, fmt.Errorf("the api_key and base_url overrides must be known to reach the Addy API")
+	}
+	return p.Sessions.Resolve(ctx, creds.ApiKey.ValueString(), creds.BaseURL.ValueString())
+}
+
+// withCredentials adds the api_key and base_url override attributes to a
+// data source schema.
+func withCredentials(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["api_key"] = schema.StringAttribute{
+		MarkdownDescription: "API key used instead of the provider's for this data source.",
+		Optional:            true,
+		Sensitive:           true,
+	}
+	attributes["base_url"] = schema.StringAttribute{
+		MarkdownDescription: "Base URL of the Addy instance used instead of the provider's for this data source.",
+		Optional:            true,
+	}
+	return attributes
+}
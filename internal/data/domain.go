@@ -2,14 +2,22 @@ package data
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource = &domainDataSource{}
+	_ datasource.DataSource                   = &domainDataSource{}
+	_ datasource.DataSourceWithConfigure      = &domainDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &domainDataSource{}
 )
 
 // NewDomainDataSource is a helper function to simplify the provider implementation.
@@ -18,7 +26,28 @@ func NewDomainDataSource() datasource.DataSource {
 }
 
 // domainDataSource is the data source implementation.
-type domainDataSource struct{}
+type domainDataSource struct {
+	providerData *DataSourceData
+}
+
+type domainDataSourceModel struct {
+	credentialsModel
+	ID                      types.String `tfsdk:"id"`
+	Domain                  types.String `tfsdk:"domain"`
+	Description             types.String `tfsdk:"description"`
+	Managed                 types.Bool   `tfsdk:"managed"`
+	FromName                types.String `tfsdk:"from_name"`
+	AutoCreateRegex         types.String `tfsdk:"auto_create_regex"`
+	DefaultRecipientID      types.String `tfsdk:"default_recipient_id"`
+	DefaultRecipientEmail   types.String `tfsdk:"default_recipient_email"`
+	Active                  types.Bool   `tfsdk:"active"`
+	CatchAll                types.Bool   `tfsdk:"catch_all"`
+	AliasesCount            types.Int64  `tfsdk:"aliases_count"`
+	DomainVerifiedAt        types.String `tfsdk:"domain_verified_at"`
+	DomainMxValidatedAt     types.String `tfsdk:"domain_mx_validated_at"`
+	DomainSendingVerifiedAt types.String `tfsdk:"domain_sending_verified_at"`
+	CreatedAt               types.String `tfsdk:"created_at"`
+}
 
 // Metadata returns the data source type name.
 func (d *domainDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -27,10 +56,173 @@ func (d *domainDataSource) Metadata(_ context.Context, req datasource.MetadataRe
 
 // Schema defines the schema for the data source.
 func (d *domainDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches a custom domain by ID or domain name.",
+
+		Attributes: withCredentials(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the domain. Exactly one of `id` and `domain` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name. Exactly one of `id` and `domain` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the domain, without the managed marker.",
+				Computed:            true,
+			},
+			"managed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the description carries the provider's managed marker.",
+				Computed:            true,
+			},
+			"from_name": schema.StringAttribute{
+				MarkdownDescription: "The from name used for aliases on the domain.",
+				Computed:            true,
+			},
+			"auto_create_regex": schema.StringAttribute{
+				MarkdownDescription: "Regular expression that aliases must match to be created on the fly.",
+				Computed:            true,
+			},
+			"default_recipient_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the domain's default recipient. Null if it uses the account default.",
+				Computed:            true,
+			},
+			"default_recipient_email": schema.StringAttribute{
+				MarkdownDescription: "The email of the domain's default recipient. Null if it uses the account default.",
+				Computed:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the domain is active.",
+				Computed:            true,
+			},
+			"catch_all": schema.BoolAttribute{
+				MarkdownDescription: "Whether aliases are created automatically when mail is received for them.",
+				Computed:            true,
+			},
+			"aliases_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of aliases on the domain.",
+				Computed:            true,
+			},
+			"domain_verified_at": schema.StringAttribute{
+				MarkdownDescription: "When domain ownership was verified. Null if not verified.",
+				Computed:            true,
+			},
+			"domain_mx_validated_at": schema.StringAttribute{
+				MarkdownDescription: "When the MX records were validated. Null if not validated.",
+				Computed:            true,
+			},
+			"domain_sending_verified_at": schema.StringAttribute{
+				MarkdownDescription: "When the domain was verified for sending. Null if not verified.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The creation timestamp of the domain.",
+				Computed:            true,
+			},
+		}),
+	}
+}
+
+// ValidateConfig requires exactly one of id and domain.
+func (d *domainDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config domainDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ID.IsUnknown() || config.Domain.IsUnknown() {
+		return
+	}
+	if config.ID.IsNull() == config.Domain.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Domain Lookup",
+			"Exactly one of id and domain must be set.",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *domainDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DataSourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *DataSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *domainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	panic("not implemented")
+	var state domainDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := state.ID.ValueString()
+	if key == "" {
+		key = state.Domain.ValueString()
+	}
+
+	tflog.Debug(ctx, "Reading domain", map[string]interface{}{
+		"key": key,
+	})
+
+	session, err := d.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Addy API Session",
+			err.Error(),
+		)
+		return
+	}
+
+	domain, err := utils.FindDomain(ctx, session, key)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Domain",
+			err.Error(),
+		)
+		return
+	}
+
+	description := ""
+	if domain.Description != nil {
+		description = *domain.Description
+	}
+
+	state.ID = types.StringValue(domain.ID)
+	state.Domain = types.StringValue(domain.Domain)
+	state.Description = types.StringValue(utils.StripMarker(description, d.providerData.ManagedMarker))
+	state.Managed = types.BoolValue(utils.HasMarker(description, d.providerData.ManagedMarker))
+	state.FromName = types.StringPointerValue(domain.FromName)
+	state.AutoCreateRegex = types.StringPointerValue(domain.AutoCreateRegex)
+	state.DefaultRecipientID = types.StringNull()
+	state.DefaultRecipientEmail = types.StringNull()
+	if domain.DefaultRecipient != nil {
+		state.DefaultRecipientID = types.StringValue(domain.DefaultRecipient.ID)
+		state.DefaultRecipientEmail = types.StringValue(domain.DefaultRecipient.Email)
+	}
+	state.Active = types.BoolValue(domain.Active)
+	state.CatchAll = types.BoolValue(domain.CatchAll)
+	state.AliasesCount = types.Int64Value(domain.AliasesCount)
+	state.DomainVerifiedAt = types.StringPointerValue(domain.DomainVerifiedAt)
+	state.DomainMxValidatedAt = types.StringPointerValue(domain.DomainMxValidatedAt)
+	state.DomainSendingVerifiedAt = types.StringPointerValue(domain.DomainSendingVerifiedAt)
+	state.CreatedAt = types.StringValue(domain.CreatedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
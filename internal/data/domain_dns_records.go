@@ -0,0 +1,236 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &domainDNSRecordsDataSource{}
+	_ datasource.DataSourceWithConfigure = &domainDNSRecordsDataSource{}
+)
+
+// defaultDNSRecordTTL is the TTL of the records when ttl is not set.
+const defaultDNSRecordTTL = 3600
+
+func NewDomainDNSRecordsDataSource() datasource.DataSource {
+	return &domainDNSRecordsDataSource{}
+}
+
+type domainDNSRecordsDataSource struct {
+	providerData *DataSourceData
+}
+
+type domainDNSRecordsDataSourceModel struct {
+	credentialsModel
+	Domain            types.String     `tfsdk:"domain"`
+	DomainID          types.String     `tfsdk:"domain_id"`
+	MxHosts           types.List       `tfsdk:"mx_hosts"`
+	SpfInclude        types.String     `tfsdk:"spf_include"`
+	DkimDomain        types.String     `tfsdk:"dkim_domain"`
+	VerificationToken types.String     `tfsdk:"verification_token"`
+	TTL               types.Int64      `tfsdk:"ttl"`
+	Records           []dnsRecordModel `tfsdk:"records"`
+	Zonefile          types.String     `tfsdk:"zonefile"`
+}
+
+type dnsRecordModel struct {
+	Type     types.String `tfsdk:"type"`
+	Name     types.String `tfsdk:"name"`
+	Value    types.String `tfsdk:"value"`
+	Priority types.Int64  `tfsdk:"priority"`
+	TTL      types.Int64  `tfsdk:"ttl"`
+}
+
+func (d *domainDNSRecordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_dns_records"
+}
+
+func (d *domainDNSRecordsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the MX, SPF, DKIM, DMARC and verification records a custom domain needs, " +
+			"normalized for use with DNS provider resources.",
+
+		Attributes: withCredentials(map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The custom domain, by ID or name.",
+				Required:            true,
+			},
+			"domain_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the custom domain.",
+				Computed:            true,
+			},
+			"mx_hosts": schema.ListAttribute{
+				MarkdownDescription: "The mail servers the MX records point at, in order of preference. Defaults to `" +
+					strings.Join(utils.HostedDNSTargets.MXHosts, "`, `") + "`, those of the hosted service; " +
+					"self-hosted instances must set their own.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"spf_include": schema.StringAttribute{
+				MarkdownDescription: "The domain the SPF record includes. Defaults to `" + utils.HostedDNSTargets.SPFInclude + "`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"dkim_domain": schema.StringAttribute{
+				MarkdownDescription: "The domain the `dk1` and `dk2` DKIM selectors are delegated to with CNAME records. " +
+					"Defaults to `" + utils.HostedDNSTargets.DKIMDomain + "`.",
+				Optional: true,
+				Computed: true,
+			},
+			"verification_token": schema.StringAttribute{
+				MarkdownDescription: "The `aa-verify` token shown in the Addy web UI. The API does not expose it, " +
+					"so the verification TXT record is only included when this is set.",
+				Optional: true,
+			},
+			"ttl": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The TTL of every record. Defaults to `%d`.", defaultDNSRecordTTL),
+				Optional:            true,
+			},
+			"records": schema.ListNestedAttribute{
+				MarkdownDescription: "The records to create.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The record type: `MX`, `TXT` or `CNAME`.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The record name relative to the domain, `@` for the apex.",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The record value. Host names are fully qualified with a trailing dot.",
+							Computed:            true,
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "The MX priority. Null for other record types.",
+							Computed:            true,
+						},
+						"ttl": schema.Int64Attribute{
+							MarkdownDescription: "The record TTL in seconds.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"zonefile": schema.StringAttribute{
+				MarkdownDescription: "The records rendered as a BIND zone file fragment.",
+				Computed:            true,
+			},
+		}),
+	}
+}
+
+func (d *domainDNSRecordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DataSourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *DataSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *domainDNSRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state domainDNSRecordsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading domain DNS records", map[string]interface{}{
+		"domain": state.Domain.ValueString(),
+	})
+
+	session, err := d.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Addy API Session",
+			err.Error(),
+		)
+		return
+	}
+
+	domain, err := utils.FindDomain(ctx, session, state.Domain.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Domain",
+			err.Error(),
+		)
+		return
+	}
+
+	targets := utils.HostedDNSTargets
+	if state.MxHosts.IsNull() {
+		mxHosts, diags := types.ListValueFrom(ctx, types.StringType, targets.MXHosts)
+		resp.Diagnostics.Append(diags...)
+		state.MxHosts = mxHosts
+	} else {
+		resp.Diagnostics.Append(state.MxHosts.ElementsAs(ctx, &targets.MXHosts, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(targets.MXHosts) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("mx_hosts"),
+			"Invalid MX Hosts",
+			"At least one MX host must be set.",
+		)
+		return
+	}
+
+	if state.SpfInclude.IsNull() {
+		state.SpfInclude = types.StringValue(targets.SPFInclude)
+	}
+	targets.SPFInclude = state.SpfInclude.ValueString()
+	if state.DkimDomain.IsNull() {
+		state.DkimDomain = types.StringValue(targets.DKIMDomain)
+	}
+	targets.DKIMDomain = state.DkimDomain.ValueString()
+
+	ttl := int64(defaultDNSRecordTTL)
+	if !state.TTL.IsNull() {
+		ttl = state.TTL.ValueInt64()
+	}
+
+	records := utils.DomainDNSRecords(targets, state.VerificationToken.ValueString(), ttl)
+
+	state.DomainID = types.StringValue(domain.ID)
+	state.Records = make([]dnsRecordModel, 0, len(records))
+	for _, record := range records {
+		priority := types.Int64Null()
+		if record.Type == "MX" {
+			priority = types.Int64Value(record.Priority)
+		}
+		state.Records = append(state.Records, dnsRecordModel{
+			Type:     types.StringValue(record.Type),
+			Name:     types.StringValue(record.Name),
+			Value:    types.StringValue(record.Value),
+			Priority: priority,
+			TTL:      types.Int64Value(record.TTL),
+		})
+	}
+	state.Zonefile = types.StringValue(utils.RenderZonefile(domain.Domain, records))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
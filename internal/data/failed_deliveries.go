@@ -0,0 +1,151 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &failedDeliveriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &failedDeliveriesDataSource{}
+)
+
+func NewFailedDeliveriesDataSource() datasource.DataSource {
+	return &failedDeliveriesDataSource{}
+}
+
+type failedDeliveriesDataSource struct {
+	providerData *DataSourceData
+}
+
+type failedDeliveriesModel struct {
+	credentialsModel
+	ID               types.String          `tfsdk:"id"`
+	AliasID          types.String          `tfsdk:"alias_id"`
+	RecipientID      types.String          `tfsdk:"recipient_id"`
+	BounceType       types.String          `tfsdk:"bounce_type"`
+	CreatedAfter     types.String          `tfsdk:"created_after"`
+	FailedDeliveries []failedDeliveryModel `tfsdk:"failed_deliveries"`
+}
+
+func (d *failedDeliveriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_failed_deliveries"
+}
+
+func (d *failedDeliveriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists failed deliveries, optionally filtered by alias, recipient, bounce type and creation time.",
+
+		Attributes: withCredentials(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Placeholder identifier attribute.",
+				Computed:            true,
+			},
+			"alias_id": schema.StringAttribute{
+				MarkdownDescription: "Only list failed deliveries for this alias.",
+				Optional:            true,
+			},
+			"recipient_id": schema.StringAttribute{
+				MarkdownDescription: "Only list failed deliveries for this recipient.",
+				Optional:            true,
+			},
+			"bounce_type": schema.StringAttribute{
+				MarkdownDescription: "Only list failed deliveries with this bounce type, e.g. `hard` or `soft`.",
+				Optional:            true,
+			},
+			"created_after": schema.StringAttribute{
+				MarkdownDescription: "Only list failed deliveries recorded after this RFC 3339 timestamp.",
+				Optional:            true,
+			},
+			"failed_deliveries": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching failed deliveries.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: failedDeliveryAttributes(),
+				},
+			},
+		}),
+	}
+}
+
+func (d *failedDeliveriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DataSourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *DataSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *failedDeliveriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state failedDeliveriesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := utils.FailedDeliveryFilter{
+		AliasID:     state.AliasID.ValueString(),
+		RecipientID: state.RecipientID.ValueString(),
+		BounceType:  state.BounceType.ValueString(),
+	}
+	if !state.CreatedAfter.IsNull() {
+		createdAfter, err := utils.ParseTimestamp(state.CreatedAfter.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("created_after"), "Invalid Created After Timestamp", err.Error())
+			return
+		}
+		filter.CreatedAfter = &createdAfter
+	}
+
+	tflog.Debug(ctx, "Listing failed deliveries")
+
+	session, err := d.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Addy API Session",
+			err.Error(),
+		)
+		return
+	}
+
+	deliveries, err := utils.ListFailedDeliveries(ctx, session)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Failed Deliveries",
+			err.Error(),
+		)
+		return
+	}
+
+	state.FailedDeliveries = []failedDeliveryModel{}
+	for _, delivery := range deliveries {
+		if filter.Matches(&delivery) {
+			state.FailedDeliveries = append(state.FailedDeliveries, newFailedDeliveryModel(&delivery))
+		}
+	}
+	state.ID = types.StringValue("failed-deliveries")
+
+	tflog.Debug(ctx, "Failed deliveries listed successfully", map[string]interface{}{
+		"total":   len(deliveries),
+		"matched": len(state.FailedDeliveries),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,200 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &failedDeliveryDataSource{}
+	_ datasource.DataSourceWithConfigure = &failedDeliveryDataSource{}
+)
+
+func NewFailedDeliveryDataSource() datasource.DataSource {
+	return &failedDeliveryDataSource{}
+}
+
+type failedDeliveryDataSource struct {
+	providerData *DataSourceData
+}
+
+type failedDeliveryDataSourceModel struct {
+	credentialsModel
+	failedDeliveryModel
+}
+
+// failedDeliveryModel maps a failed delivery to a Go type. It is shared by
+// the single lookup and the entries of addy_failed_deliveries.
+type failedDeliveryModel struct {
+	ID             types.String `tfsdk:"id"`
+	AliasID        types.String `tfsdk:"alias_id"`
+	AliasEmail     types.String `tfsdk:"alias_email"`
+	RecipientID    types.String `tfsdk:"recipient_id"`
+	RecipientEmail types.String `tfsdk:"recipient_email"`
+	Code           types.String `tfsdk:"code"`
+	BounceType     types.String `tfsdk:"bounce_type"`
+	RemoteMta      types.String `tfsdk:"remote_mta"`
+	Sender         types.String `tfsdk:"sender"`
+	Destination    types.String `tfsdk:"destination"`
+	EmailType      types.String `tfsdk:"email_type"`
+	Status         types.String `tfsdk:"status"`
+	AttemptedAt    types.String `tfsdk:"attempted_at"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+func (d *failedDeliveryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_failed_delivery"
+}
+
+func (d *failedDeliveryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := failedDeliveryAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the failed delivery to look up.",
+		Required:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches a single failed delivery by ID.",
+
+		Attributes: withCredentials(attributes),
+	}
+}
+
+// failedDeliveryAttributes returns the computed attributes of a failed delivery.
+func failedDeliveryAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the failed delivery.",
+			Computed:            true,
+		},
+		"alias_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the alias the message was sent to. Null if not sent to an alias.",
+			Computed:            true,
+		},
+		"alias_email": schema.StringAttribute{
+			MarkdownDescription: "The email of the alias the message was sent to.",
+			Computed:            true,
+		},
+		"recipient_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the recipient the message could not be delivered to.",
+			Computed:            true,
+		},
+		"recipient_email": schema.StringAttribute{
+			MarkdownDescription: "The email of the recipient the message could not be delivered to.",
+			Computed:            true,
+		},
+		"code": schema.StringAttribute{
+			MarkdownDescription: "The response the remote server gave for the failure.",
+			Computed:            true,
+		},
+		"bounce_type": schema.StringAttribute{
+			MarkdownDescription: "The bounce type, e.g. `hard` or `soft`.",
+			Computed:            true,
+		},
+		"remote_mta": schema.StringAttribute{
+			MarkdownDescription: "The remote mail server that rejected the message.",
+			Computed:            true,
+		},
+		"sender": schema.StringAttribute{
+			MarkdownDescription: "The sender of the message.",
+			Computed:            true,
+		},
+		"destination": schema.StringAttribute{
+			MarkdownDescription: "The address the message was being delivered to.",
+			Computed:            true,
+		},
+		"email_type": schema.StringAttribute{
+			MarkdownDescription: "The type of email that failed, e.g. a forward or a reply.",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "The delivery status reported by the remote server.",
+			Computed:            true,
+		},
+		"attempted_at": schema.StringAttribute{
+			MarkdownDescription: "When delivery was attempted.",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "When the failed delivery was recorded.",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *failedDeliveryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DataSourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *DataSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *failedDeliveryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state failedDeliveryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading failed delivery", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	session, err := d.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Addy API Session",
+			err.Error(),
+		)
+		return
+	}
+
+	delivery, err := utils.GetFailedDelivery(ctx, session, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Failed Delivery",
+			err.Error(),
+		)
+		return
+	}
+
+	state.failedDeliveryModel = newFailedDeliveryModel(delivery)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func newFailedDeliveryModel(delivery *utils.FailedDelivery) failedDeliveryModel {
+	return failedDeliveryModel{
+		ID:             types.StringValue(delivery.ID),
+		AliasID:        types.StringPointerValue(delivery.AliasID),
+		AliasEmail:     types.StringPointerValue(delivery.AliasEmail),
+		RecipientID:    types.StringPointerValue(delivery.RecipientID),
+		RecipientEmail: types.StringPointerValue(delivery.RecipientEmail),
+		Code:           types.StringPointerValue(delivery.Code),
+		BounceType:     types.StringPointerValue(delivery.BounceType),
+		RemoteMta:      types.StringPointerValue(delivery.RemoteMta),
+		Sender:         types.StringPointerValue(delivery.Sender),
+		Destination:    types.StringPointerValue(delivery.Destination),
+		EmailType:      types.StringPointerValue(delivery.EmailType),
+		Status:         types.StringPointerValue(delivery.Status),
+		AttemptedAt:    types.StringPointerValue(delivery.AttemptedAt),
+		CreatedAt:      types.StringValue(delivery.CreatedAt),
+	}
+}
@@ -4,8 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
-	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -23,11 +21,11 @@ func NewApiTokenDetailsDataSource() datasource.DataSource {
 }
 
 type apiTokenDetailsDataSource struct {
-	client  *http.Client
-	apiKey  string
+	providerData *DataSourceData
 }
 
 type apiTokenDetailsModel struct {
+	credentialsModel
 	ID        types.String `tfsdk:"id"`
 	Name      types.String `tfsdk:"name"`
 	CreatedAt types.String `tfsdk:"created_at"`
@@ -48,7 +46,7 @@ func (d *apiTokenDetailsDataSource) Schema(ctx context.Context, req datasource.S
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Fetches details about the current API token.",
 
-		Attributes: map[string]schema.Attribute{
+		Attributes: withCredentials(map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Placeholder identifier attribute.",
 				Computed:            true,
@@ -65,7 +63,7 @@ func (d *apiTokenDetailsDataSource) Schema(ctx context.Context, req datasource.S
 				MarkdownDescription: "The expiration timestamp of the API token. Null if the token doesn't expire.",
 				Computed:            true,
 			},
-		},
+		}),
 	}
 }
 
@@ -83,16 +81,28 @@ func (d *apiTokenDetailsDataSource) Configure(ctx context.Context, req datasourc
 		return
 	}
 
-	d.client = providerData.Client
-	d.apiKey = providerData.ApiKey
+	d.providerData = providerData
 }
 
 func (d *apiTokenDetailsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state apiTokenDetailsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Debug(ctx, "Reading API token details")
 
-	body, err := utils.Curl(ctx, d.client, "api-token-details", "GET", d.apiKey)
+	session, err := d.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Addy API Session",
+			err.Error(),
+		)
+		return
+	}
+
+	body, err := session.Curl(ctx, "api-token-details", "GET")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read API Token Details",
@@ -114,7 +124,7 @@ func (d *apiTokenDetailsDataSource) Read(ctx context.Context, req datasource.Rea
 	state.ID = types.StringValue("api-token-details")
 	state.Name = types.StringValue(tokenDetails.Name)
 	state.CreatedAt = types.StringValue(tokenDetails.CreatedAt)
-	
+
 	if tokenDetails.ExpiresAt != nil {
 		state.ExpiresAt = types.StringValue(*tokenDetails.ExpiresAt)
 	} else {
@@ -128,4 +138,4 @@ func (d *apiTokenDetailsDataSource) Read(ctx context.Context, req datasource.Rea
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-}
\ No newline at end of file
+}
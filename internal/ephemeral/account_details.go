@@ -0,0 +1,112 @@
+package ephemeral
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/account"
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &accountDetailsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &accountDetailsEphemeralResource{}
+)
+
+func NewAccountDetailsEphemeralResource() ephemeral.EphemeralResource {
+	return &accountDetailsEphemeralResource{}
+}
+
+type accountDetailsEphemeralResource struct {
+	providerData *EphemeralResourceData
+}
+
+type accountDetailsModel struct {
+	credentialsModel
+	account.DetailsModel
+}
+
+func (e *accountDetailsEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_details"
+}
+
+func (e *accountDetailsEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the subscription, bandwidth, limits and counts of the current account without storing them in state.",
+
+		Attributes: withCredentials(accountDetailsAttributes()),
+	}
+}
+
+func (e *accountDetailsEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*EphemeralResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *EphemeralResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.providerData = providerData
+}
+
+func (e *accountDetailsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config accountDetailsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Opening account details")
+
+	session, err := e.providerData.session(ctx, config.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Addy API Session",
+			err.Error(),
+		)
+		return
+	}
+
+	details, err := utils.GetAccountDetails(ctx, session)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Account Details",
+			err.Error(),
+		)
+		return
+	}
+
+	result := accountDetailsModel{DetailsModel: account.NewDetailsModel(details)}
+	result.credentialsModel = config.credentialsModel
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &result)...)
+}
+
+// accountDetailsAttributes builds the ephemeral resource schema attributes for
+// account.DetailsModel.
+func accountDetailsAttributes() map[string]schema.Attribute {
+	attributes := make(map[string]schema.Attribute, len(account.Attributes))
+	for name, attribute := range account.Attributes {
+		if attribute.Int64 {
+			attributes[name] = schema.Int64Attribute{
+				MarkdownDescription: attribute.Description,
+				Computed:            true,
+			}
+			continue
+		}
+		attributes[name] = schema.StringAttribute{
+			MarkdownDescription: attribute.Description,
+			Computed:            true,
+		}
+	}
+	return attributes
+}
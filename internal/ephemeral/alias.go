@@ -0,0 +1,358 @@
+package ephemeral
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource                   = &aliasEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &aliasEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose          = &aliasEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &aliasEphemeralResource{}
+)
+
+// aliasPrivateKey is the private data key holding what Close needs to clean up.
+const aliasPrivateKey = "alias"
+
+// NewAliasEphemeralResource is a helper function to simplify the provider implementation.
+func NewAliasEphemeralResource() ephemeral.EphemeralResource {
+	return &aliasEphemeralResource{}
+}
+
+// aliasEphemeralResource creates a throwaway alias for the duration of a run.
+type aliasEphemeralResource struct {
+	providerData *EphemeralResourceData
+}
+
+type aliasEphemeralModel struct {
+	credentialsModel
+	ID              types.String `tfsdk:"id"`
+	Email           types.String `tfsdk:"email"`
+	Domain          types.String `tfsdk:"domain"`
+	Format          types.String `tfsdk:"format"`
+	LocalPart       types.String `tfsdk:"local_part"`
+	Description     types.String `tfsdk:"description"`
+	FromName        types.String `tfsdk:"from_name"`
+	RecipientIds    types.List   `tfsdk:"recipient_ids"`
+	RecipientEmails types.List   `tfsdk:"recipient_emails"`
+	OnClose         types.String `tfsdk:"on_close"`
+}
+
+// aliasPrivate is stored in private data between Open and Close. Close gets
+// no configuration, so the credential overrides are kept here as well.
+type aliasPrivate struct {
+	ID      string `json:"id"`
+	OnClose string `json:"on_close"`
+	ApiKey  string `json:"api_key,omitempty"`
+	BaseURL string `json:"base_url,omitempty"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *aliasEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alias"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *aliasEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a throwaway alias when opened and removes it when closed. " +
+			"The alias is never written to state. Unset attributes fall back to the provider `defaults` block. " +
+			"If a create request fails without a clear answer, the alias it may have created is found and reused rather than duplicated.",
+
+		Attributes: withCredentials(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the alias.",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the alias.",
+				Computed:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain of the alias.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: "The format of the alias: `random_characters`, `uuid`, `random_words` or `custom`.",
+				Optional:            true,
+			},
+			"local_part": schema.StringAttribute{
+				MarkdownDescription: "The local part of the alias. Required when `format` is `custom`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description of the alias.",
+				Optional:            true,
+			},
+			"from_name": schema.StringAttribute{
+				MarkdownDescription: "The from name used when replying from the alias.",
+				Optional:            true,
+			},
+			"recipient_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the recipients the alias forwards to.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+			},
+			"recipient_emails": schema.ListAttribute{
+				MarkdownDescription: "Emails of the recipients the alias forwards to, resolved to IDs on open. " +
+					"Conflicts with `recipient_ids`. Unknown and unverified recipients are errors.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"on_close": schema.StringAttribute{
+				MarkdownDescription: "What to do with the alias when closed: `deactivate` keeps it but stops forwarding, " +
+					"`delete` (default) soft deletes it and `forget` removes it permanently.",
+				Optional: true,
+			},
+		}),
+	}
+}
+
+// ValidateConfig checks on_close and the custom format's local part.
+func (e *aliasEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config aliasEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.OnClose.IsNull() && !config.OnClose.IsUnknown() {
+		if !slices.Contains(utils.AliasDeletionModes, config.OnClose.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("on_close"),
+				"Invalid On Close Value",
+				"on_close must be one of "+strings.Join(utils.AliasDeletionModes, ", ")+", got: "+config.OnClose.ValueString(),
+			)
+		}
+	}
+
+	if !config.RecipientIds.IsNull() && !config.RecipientEmails.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("recipient_emails"),
+			"Conflicting Recipients",
+			"Only one of recipient_ids and recipient_emails can be set.",
+		)
+	}
+
+	if config.Format.ValueString() == "custom" && config.LocalPart.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("local_part"),
+			"Missing Local Part",
+			"local_part must be set when format is custom.",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *aliasEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*EphemeralResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *EphemeralResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.providerData = providerData
+}
+
+// Open creates the alias and returns its email. If a later step fails, the
+// alias is removed again as Close would have.
+func (e *aliasEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data aliasEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaults := e.providerData.Defaults
+	create := utils.AliasCreate{
+		Domain:       data.Domain.ValueString(),
+		Format:       data.Format.ValueString(),
+		LocalPart:    data.LocalPart.ValueString(),
+		RecipientIds: defaults.RecipientIds,
+	}
+	if create.Domain == "" {
+		create.Domain = defaults.AliasDomain
+	}
+	if create.Format == "" {
+		create.Format = defaults.AliasFormat
+	}
+	if description := utils.AppendMarker(data.Description.ValueString(), e.providerData.ManagedMarker); description != "" {
+		create.Description = &description
+	}
+	if !data.RecipientIds.IsNull() {
+		resp.Diagnostics.Append(data.RecipientIds.ElementsAs(ctx, &create.RecipientIds, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	fromName := data.FromName.ValueString()
+	if data.FromName.IsNull() {
+		fromName = defaults.FromName
+	}
+
+	session, err := e.providerData.session(ctx, data.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	if !data.RecipientEmails.IsNull() {
+		var emails []string
+		resp.Diagnostics.Append(data.RecipientEmails.ElementsAs(ctx, &emails, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		recipients, err := utils.RecipientsByEmail(ctx, session, emails)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("recipient_emails"), "Unable to Resolve Recipient Emails", err.Error())
+			return
+		}
+
+		create.RecipientIds = make([]string, 0, len(recipients))
+		for _, recipient := range recipients {
+			create.RecipientIds = append(create.RecipientIds, recipient.ID)
+		}
+	}
+
+	tflog.Debug(ctx, "Creating ephemeral alias", map[string]interface{}{
+		"domain": create.Domain,
+		"format": create.Format,
+	})
+
+	alias, err := utils.CreateAliasIdempotent(ctx, session, create)
+	if alias == nil {
+		resp.Diagnostics.AddError("Unable to Create Alias", err.Error())
+		return
+	}
+	if err != nil {
+		// The alias works but its description still carries the nonce.
+		resp.Diagnostics.AddWarning("Unable to Clean Up Alias Description", err.Error())
+	}
+
+	onClose := data.OnClose.ValueString()
+	if onClose == "" {
+		onClose = "delete"
+	}
+
+	// Close is not called when Open fails, so remove the alias here.
+	id, email := alias.ID, alias.Email
+	defer func() {
+		if !resp.Diagnostics.HasError() {
+			return
+		}
+		if err := utils.RemoveAlias(ctx, session, id, onClose); err != nil && !utils.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Unable to Remove Alias",
+				fmt.Sprintf("The alias %s (%s) was left behind after the errors above: %s", email, id, err.Error()),
+			)
+		}
+	}()
+
+	private, err := json.Marshal(aliasPrivate{
+		ID:      alias.ID,
+		OnClose: onClose,
+		ApiKey:  data.ApiKey.ValueString(),
+		BaseURL: data.BaseURL.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Encode Alias Private Data", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, aliasPrivateKey, private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if fromName != "" {
+		alias, err = utils.UpdateAlias(ctx, session, alias.ID, utils.AliasUpdate{
+			Description: create.Description,
+			FromName:    &fromName,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Set Alias From Name", err.Error())
+			return
+		}
+	}
+
+	data.ID = types.StringValue(alias.ID)
+	data.Email = types.StringValue(alias.Email)
+	data.Domain = types.StringValue(alias.Domain)
+	data.LocalPart = types.StringValue(alias.LocalPart)
+
+	recipientIds := make([]string, 0, len(alias.Recipients))
+	recipientEmails := make([]string, 0, len(alias.Recipients))
+	for _, recipient := range alias.Recipients {
+		recipientIds = append(recipientIds, recipient.ID)
+		recipientEmails = append(recipientEmails, recipient.Email)
+	}
+	if data.RecipientIds.IsNull() {
+		var diags diag.Diagnostics
+		data.RecipientIds, diags = types.ListValueFrom(ctx, types.StringType, recipientIds)
+		resp.Diagnostics.Append(diags...)
+	}
+	if data.RecipientEmails.IsNull() {
+		var diags diag.Diagnostics
+		data.RecipientEmails, diags = types.ListValueFrom(ctx, types.StringType, recipientEmails)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Close deactivates, deletes or forgets the alias created by Open.
+func (e *aliasEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	raw, diags := req.Private.GetKey(ctx, aliasPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || raw == nil {
+		return
+	}
+
+	var private aliasPrivate
+	if err := json.Unmarshal(raw, &private); err != nil {
+		resp.Diagnostics.AddError("Unable to Decode Alias Private Data", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Closing ephemeral alias", map[string]interface{}{
+		"id":       private.ID,
+		"on_close": private.OnClose,
+	})
+
+	session, err := e.providerData.Sessions.Resolve(ctx, private.ApiKey, private.BaseURL)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	err = utils.RemoveAlias(ctx, session, private.ID, private.OnClose)
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Remove Alias", err.Error())
+	}
+}
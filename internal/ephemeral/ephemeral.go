@@ -0,0 +1,48 @@
+package ephemeral
+
+import (
+	"context"
+	"fmt"
+
+	addyresource "github.com/aRustyDev/terraform-provider-addy/internal/resource"
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type EphemeralResourceData struct {
+	Sessions      *utils.Sessions
+	ManagedMarker string
+	Defaults      addyresource.AliasDefaults
+}
+
+// credentialsModel maps the optional per-resource credential overrides.
+type credentialsModel struct {
+	ApiKey  types.String `tfsdk:"api_key"`
+	BaseURL types.String `tfsdk:"base_url"`
+}
+
+// session returns the session for the ephemeral resource's credential
+// overrides, falling back to the provider configuration.
+func (p *EphemeralResourceData) session(ctx context.Context, creds credentialsModel) (*utils.Session, error) {
+	if creds.ApiKey.IsUnknown() || creds.BaseURL.IsUnknown() {
+		return nil, fmt.Errorf("the api_key and base_url overrides must be known to reach the Addy API")
+	}
+	return p.Sessions.Resolve(ctx, creds.ApiKey.ValueString(), creds.BaseURL.ValueString())
+}
+
+// withCredentials adds the api_key and base_url override attributes to an
+// ephemeral resource schema.
+func withCredentials(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["api_key"] = schema.StringAttribute{
+		MarkdownDescription: "API key used instead of the provider's for this ephemeral resource.",
+		Optional:            true,
+		Sensitive:           true,
+	}
+	attributes["base_url"] = schema.StringAttribute{
+		MarkdownDescription: "Base URL of the Addy instance used instead of the provider's for this ephemeral resource.",
+		Optional:            true,
+	}
+	return attributes
+}
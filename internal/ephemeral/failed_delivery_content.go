@@ -0,0 +1,209 @@
+package ephemeral
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net/mail"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &failedDeliveryContentEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &failedDeliveryContentEphemeralResource{}
+)
+
+// NewFailedDeliveryContentEphemeralResource is a helper function to simplify the provider implementation.
+func NewFailedDeliveryContentEphemeralResource() ephemeral.EphemeralResource {
+	return &failedDeliveryContentEphemeralResource{}
+}
+
+// failedDeliveryContentEphemeralResource downloads the original message of a
+// failed delivery so bounces can be inspected without storing email content.
+type failedDeliveryContentEphemeralResource struct {
+	providerData *EphemeralResourceData
+}
+
+type failedDeliveryContentModel struct {
+	credentialsModel
+	ID           types.String `tfsdk:"id"`
+	Raw          types.String `tfsdk:"raw"`
+	RawBase64    types.String `tfsdk:"raw_base64"`
+	Headers      types.Map    `tfsdk:"headers"`
+	Subject      types.String `tfsdk:"subject"`
+	From         types.String `tfsdk:"from"`
+	Sender       types.String `tfsdk:"sender"`
+	BounceType   types.String `tfsdk:"bounce_type"`
+	BounceReason types.String `tfsdk:"bounce_reason"`
+	RemoteMta    types.String `tfsdk:"remote_mta"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *failedDeliveryContentEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_failed_delivery_content"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *failedDeliveryContentEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Downloads the original message of a failed delivery along with its bounce details, without storing either in state.",
+
+		Attributes: withCredentials(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the failed delivery.",
+				Required:            true,
+			},
+			"raw": schema.StringAttribute{
+				MarkdownDescription: "The raw RFC 822 message. Null if the message is not valid UTF-8, e.g. with an 8-bit " +
+					"body in another charset; use `raw_base64` then.",
+				Computed:  true,
+				Sensitive: true,
+			},
+			"raw_base64": schema.StringAttribute{
+				MarkdownDescription: "The raw RFC 822 message, base64 encoded. Unlike `raw`, it is set for any message.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "The message headers keyed by canonical name. Repeated headers are joined with newlines.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"subject": schema.StringAttribute{
+				MarkdownDescription: "The Subject header of the message.",
+				Computed:            true,
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "The From header of the message.",
+				Computed:            true,
+			},
+			"sender": schema.StringAttribute{
+				MarkdownDescription: "The envelope sender recorded for the failed delivery.",
+				Computed:            true,
+			},
+			"bounce_type": schema.StringAttribute{
+				MarkdownDescription: "The bounce type, e.g. `hard` or `soft`.",
+				Computed:            true,
+			},
+			"bounce_reason": schema.StringAttribute{
+				MarkdownDescription: "The response the remote server gave for the failure.",
+				Computed:            true,
+			},
+			"remote_mta": schema.StringAttribute{
+				MarkdownDescription: "The remote mail server that rejected the message.",
+				Computed:            true,
+			},
+		}),
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *failedDeliveryContentEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*EphemeralResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *EphemeralResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.providerData = providerData
+}
+
+// Open fetches the failed delivery and its original message.
+func (e *failedDeliveryContentEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data failedDeliveryContentModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := e.providerData.session(ctx, data.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+	id := data.ID.ValueString()
+
+	tflog.Debug(ctx, "Opening failed delivery content", map[string]interface{}{
+		"id": id,
+	})
+
+	delivery, err := utils.GetFailedDelivery(ctx, session, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Failed Delivery", err.Error())
+		return
+	}
+
+	raw, err := utils.DownloadFailedDelivery(ctx, session, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Download Failed Delivery", err.Error())
+		return
+	}
+
+	headers := map[string]string{}
+	message, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Parse Failed Delivery Message",
+			"The raw message is returned but its headers could not be parsed: "+err.Error(),
+		)
+	} else {
+		for name, values := range message.Header {
+			headers[name] = strings.Join(values, "\n")
+		}
+	}
+
+	headerValues, diags := types.MapValueFrom(ctx, types.StringType, headers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Terraform strings must be valid UTF-8, which raw messages need not be.
+	data.Raw = types.StringNull()
+	if utf8.Valid(raw) {
+		data.Raw = types.StringValue(string(raw))
+	}
+	data.RawBase64 = types.StringValue(base64.StdEncoding.EncodeToString(raw))
+	data.Headers = headerValues
+	data.Subject = decodedHeader(headers, "Subject")
+	data.From = decodedHeader(headers, "From")
+	data.Sender = types.StringPointerValue(delivery.Sender)
+	data.BounceType = types.StringPointerValue(delivery.BounceType)
+	data.BounceReason = types.StringPointerValue(delivery.Code)
+	data.RemoteMta = types.StringPointerValue(delivery.RemoteMta)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// decodedHeader returns the named header with any RFC 2047 encoded words
+// decoded, or null if the message does not have it.
+func decodedHeader(headers map[string]string, name string) types.String {
+	value, ok := headers[name]
+	if !ok {
+		return types.StringNull()
+	}
+
+	decoded, err := new(mime.WordDecoder).DecodeHeader(value)
+	if err != nil {
+		return types.StringValue(value)
+	}
+	return types.StringValue(decoded)
+}
@@ -0,0 +1,123 @@
+package ephemeral
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &apiTokenDetailsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &apiTokenDetailsEphemeralResource{}
+)
+
+func NewApiTokenDetailsEphemeralResource() ephemeral.EphemeralResource {
+	return &apiTokenDetailsEphemeralResource{}
+}
+
+type apiTokenDetailsEphemeralResource struct {
+	providerData *EphemeralResourceData
+}
+
+type apiTokenDetailsModel struct {
+	credentialsModel
+	Name      types.String `tfsdk:"name"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+type apiTokenDetailsResponse struct {
+	Name      string  `json:"name"`
+	CreatedAt string  `json:"created_at"`
+	ExpiresAt *string `json:"expires_at"`
+}
+
+func (e *apiTokenDetailsEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token_details"
+}
+
+func (e *apiTokenDetailsEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches details about the current API token without storing them in state.",
+
+		Attributes: withCredentials(map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the API token.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The creation timestamp of the API token.",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The expiration timestamp of the API token. Null if the token doesn't expire.",
+				Computed:            true,
+			},
+		}),
+	}
+}
+
+func (e *apiTokenDetailsEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*EphemeralResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *EphemeralResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.providerData = providerData
+}
+
+func (e *apiTokenDetailsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var result apiTokenDetailsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Opening API token details")
+
+	session, err := e.providerData.session(ctx, result.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Addy API Session",
+			err.Error(),
+		)
+		return
+	}
+
+	body, err := session.Curl(ctx, "api-token-details", "GET")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read API Token Details",
+			err.Error(),
+		)
+		return
+	}
+
+	var tokenDetails apiTokenDetailsResponse
+	if err := json.Unmarshal(body, &tokenDetails); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Parse API Token Details",
+			err.Error(),
+		)
+		return
+	}
+
+	result.Name = types.StringValue(tokenDetails.Name)
+	result.CreatedAt = types.StringValue(tokenDetails.CreatedAt)
+	result.ExpiresAt = types.StringPointerValue(tokenDetails.ExpiresAt)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &result)...)
+}
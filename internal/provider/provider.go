@@ -3,8 +3,13 @@ package provider
 import (
 	"context"
 	"os"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -13,14 +18,19 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	addyaction "github.com/aRustyDev/terraform-provider-addy/internal/action"
 	addydata "github.com/aRustyDev/terraform-provider-addy/internal/data"
+	addyephemeral "github.com/aRustyDev/terraform-provider-addy/internal/ephemeral"
 	addyresource "github.com/aRustyDev/terraform-provider-addy/internal/resource"
 	addyutils "github.com/aRustyDev/terraform-provider-addy/internal/utils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &addyProvider{}
+	_ provider.Provider                       = &addyProvider{}
+	_ provider.ProviderWithListResources      = &addyProvider{}
+	_ provider.ProviderWithEphemeralResources = &addyProvider{}
+	_ provider.ProviderWithActions            = &addyProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -42,7 +52,18 @@ type addyProvider struct {
 
 // hashicupsProviderModel maps provider schema data to a Go type.
 type addyProviderModel struct {
-	ApiKey types.String `tfsdk:"api_key"`
+	ApiKey        types.String       `tfsdk:"api_key"`
+	BaseURL       types.String       `tfsdk:"base_url"`
+	ManagedMarker types.String       `tfsdk:"managed_marker"`
+	Defaults      *addyDefaultsModel `tfsdk:"defaults"`
+}
+
+// addyDefaultsModel maps the provider defaults block to a Go type.
+type addyDefaultsModel struct {
+	AliasDomain  types.String `tfsdk:"alias_domain"`
+	AliasFormat  types.String `tfsdk:"alias_format"`
+	RecipientIds types.List   `tfsdk:"recipient_ids"`
+	FromName     types.String `tfsdk:"from_name"`
 }
 
 // Metadata returns the provider type name.
@@ -59,6 +80,40 @@ func (p *addyProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Optional:  true,
 				Sensitive: true,
 			},
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the Addy instance, for self-hosted installations. " +
+					"Defaults to the ADDY_BASE_URL environment variable or `https://app.addy.io`.",
+				Optional: true,
+			},
+			"managed_marker": schema.StringAttribute{
+				MarkdownDescription: "Marker (e.g. `[tf]`) appended to the description of objects managed by Terraform. " +
+					"It is stripped again on read so it never shows as drift.",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"defaults": schema.SingleNestedBlock{
+				MarkdownDescription: "Default values applied during plan to aliases that omit the corresponding attributes.",
+				Attributes: map[string]schema.Attribute{
+					"alias_domain": schema.StringAttribute{
+						MarkdownDescription: "Domain used for aliases that do not set `domain`.",
+						Optional:            true,
+					},
+					"alias_format": schema.StringAttribute{
+						MarkdownDescription: "Format used for aliases that do not set `format`. One of `" + strings.Join(addyutils.AliasFormats, "`, `") + "`.",
+						Optional:            true,
+					},
+					"recipient_ids": schema.ListAttribute{
+						MarkdownDescription: "Recipient IDs attached to aliases that do not set `recipient_ids`.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"from_name": schema.StringAttribute{
+						MarkdownDescription: "From name used for aliases that do not set `from_name`.",
+						Optional:            true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -86,6 +141,35 @@ func (p *addyProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 	}
 
+	defaults := addyresource.AliasDefaults{}
+	if config.Defaults != nil {
+		if config.Defaults.AliasFormat.IsUnknown() || config.Defaults.AliasDomain.IsUnknown() ||
+			config.Defaults.FromName.IsUnknown() || config.Defaults.RecipientIds.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("defaults"),
+				"Unknown Addy Alias Defaults",
+				"The provider cannot apply alias defaults as there is an unknown configuration value in the defaults block. "+
+					"Either target apply the source of the value first or set the value statically in the configuration.",
+			)
+		}
+
+		defaults.AliasDomain = config.Defaults.AliasDomain.ValueString()
+		defaults.AliasFormat = config.Defaults.AliasFormat.ValueString()
+		defaults.FromName = config.Defaults.FromName.ValueString()
+
+		if defaults.AliasFormat != "" && !slices.Contains(addyutils.AliasFormats, defaults.AliasFormat) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("defaults").AtName("alias_format"),
+				"Invalid Addy Alias Format",
+				"The alias format must be one of "+strings.Join(addyutils.AliasFormats, ", ")+", got: "+defaults.AliasFormat,
+			)
+		}
+
+		if !config.Defaults.RecipientIds.IsNull() && !config.Defaults.RecipientIds.IsUnknown() {
+			resp.Diagnostics.Append(config.Defaults.RecipientIds.ElementsAs(ctx, &defaults.RecipientIds, false)...)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -94,11 +178,16 @@ func (p *addyProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	// with Terraform configuration value if set.
 
 	api_key := os.Getenv("ADDY_API_KEY")
+	base_url := os.Getenv("ADDY_BASE_URL")
 
 	if !config.ApiKey.IsNull() {
 		api_key = config.ApiKey.ValueString()
 	}
 
+	if !config.BaseURL.IsNull() {
+		base_url = config.BaseURL.ValueString()
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
@@ -117,7 +206,7 @@ func (p *addyProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	}
 
 	// Create a new HashiCups client using the configuration values
-	client, err := addyutils.NewClient(ctx)
+	session, err := addyutils.NewSession(ctx, base_url, api_key)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create HashiCups API Client",
@@ -128,13 +217,31 @@ func (p *addyProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
+	// Sessions for per-resource credential overrides are created on demand
+	// and cached alongside the provider-level one.
+	sessions := addyutils.NewSessions(session)
+
 	// Make the HashiCups client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = &addydata.DataSourceData{
-		Client: client,
-		ApiKey: api_key,
+		Sessions:      sessions,
+		ManagedMarker: config.ManagedMarker.ValueString(),
+	}
+	resourceData := &addyresource.ResourceData{
+		Sessions:      sessions,
+		ManagedMarker: config.ManagedMarker.ValueString(),
+		Defaults:      defaults,
+	}
+	resp.ResourceData = resourceData
+	resp.ListResourceData = resourceData
+	resp.ActionData = &addyaction.ActionData{
+		Sessions: sessions,
+	}
+	resp.EphemeralResourceData = &addyephemeral.EphemeralResourceData{
+		Sessions:      sessions,
+		ManagedMarker: config.ManagedMarker.ValueString(),
+		Defaults:      defaults,
 	}
-	resp.ResourceData = client
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -146,6 +253,10 @@ func (p *addyProvider) DataSources(_ context.Context) []func() datasource.DataSo
 		addydata.NewAliasesDataSource,
 		addydata.NewAppVersionDataSource,
 		addydata.NewApiTokenDetailsDataSource,
+		addydata.NewAccountDetailsDataSource,
+		addydata.NewFailedDeliveryDataSource,
+		addydata.NewFailedDeliveriesDataSource,
+		addydata.NewDomainDNSRecordsDataSource,
 	}
 }
 
@@ -153,5 +264,42 @@ func (p *addyProvider) DataSources(_ context.Context) []func() datasource.DataSo
 func (p *addyProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		addyresource.NewDomainResource,
+		addyresource.NewDomainVerificationResource,
+		addyresource.NewAliasRecipientsResource,
+		addyresource.NewAliasResource,
+		addyresource.NewRecipientResource,
+		addyresource.NewUsernameResource,
+		addyresource.NewRuleResource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *addyProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		addyephemeral.NewAliasEphemeralResource,
+		addyephemeral.NewApiTokenDetailsEphemeralResource,
+		addyephemeral.NewAccountDetailsEphemeralResource,
+		addyephemeral.NewFailedDeliveryContentEphemeralResource,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider.
+func (p *addyProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		addyresource.NewDomainListResource,
+		addyresource.NewAliasListResource,
+		addyresource.NewRecipientListResource,
+		addyresource.NewUsernameListResource,
+		addyresource.NewRuleListResource,
+	}
+}
+
+// Actions defines the actions implemented in the provider.
+func (p *addyProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		addyaction.NewFailedDeliveryResendAction,
+		addyaction.NewAliasesBulkAction,
+		addyaction.NewRecipientResendVerificationAction,
+		addyaction.NewDomainCheckRecordsAction,
 	}
 }
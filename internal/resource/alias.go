@@ -0,0 +1,688 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &aliasResource{}
+	_ resource.ResourceWithConfigure      = &aliasResource{}
+	_ resource.ResourceWithModifyPlan     = &aliasResource{}
+	_ resource.ResourceWithImportState    = &aliasResource{}
+	_ resource.ResourceWithIdentity       = &aliasResource{}
+	_ resource.ResourceWithValidateConfig = &aliasResource{}
+)
+
+// NewAliasResource is a helper function to simplify the provider implementation.
+func NewAliasResource() resource.Resource {
+	return &aliasResource{}
+}
+
+// aliasResource is the resource implementation.
+type aliasResource struct {
+	providerData *ResourceData
+}
+
+type aliasModel struct {
+	credentialsModel
+	ID               types.String `tfsdk:"id"`
+	Email            types.String `tfsdk:"email"`
+	Domain           types.String `tfsdk:"domain"`
+	Format           types.String `tfsdk:"format"`
+	LocalPart        types.String `tfsdk:"local_part"`
+	Description      types.String `tfsdk:"description"`
+	FromName         types.String `tfsdk:"from_name"`
+	RecipientIds     types.Set    `tfsdk:"recipient_ids"`
+	RecipientEmails  types.Set    `tfsdk:"recipient_emails"`
+	Active           types.Bool   `tfsdk:"active"`
+	DeletionMode     types.String `tfsdk:"deletion_mode"`
+	RestoreIfDeleted types.Bool   `tfsdk:"restore_if_deleted"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+}
+
+// aliasIdentityModel identifies an alias by its ID and its email.
+type aliasIdentityModel struct {
+	ID    types.String `tfsdk:"id"`
+	Email types.String `tfsdk:"email"`
+}
+
+// Metadata returns the resource type name.
+func (r *aliasResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alias"
+}
+
+// Schema defines the schema for the resource.
+func (r *aliasResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an alias. `domain`, `format`, `recipient_ids` and `from_name` fall back to the " +
+			"provider `defaults` block during plan, so the resolved values are shown in the plan. If a create request " +
+			"fails without a clear answer, the alias it may have created is found and adopted rather than duplicated.",
+
+		Attributes: withCredentials(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the alias.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the alias.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain of the alias. Defaults to `defaults.alias_domain`, then the account's " +
+					"default alias domain. Changing this creates a new alias.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: "The format of the alias: `random_characters`, `uuid`, `random_words` or `custom`. " +
+					"Defaults to `defaults.alias_format`, then the account's default format. Only used when the alias is created.",
+				Optional: true,
+				Computed: true,
+			},
+			"local_part": schema.StringAttribute{
+				MarkdownDescription: "The local part of the alias. Required when `format` is `custom`, generated otherwise. " +
+					"Changing this creates a new alias.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description of the alias.",
+				Optional:            true,
+			},
+			"from_name": schema.StringAttribute{
+				MarkdownDescription: "The from name used when replying from the alias. Defaults to `defaults.from_name`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"recipient_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the recipients the alias forwards to. Defaults to `defaults.recipient_ids` when " +
+					"the alias is created. When neither this nor `recipient_emails` is set, the recipients are left to " +
+					"`addy_alias_recipients` or the account default.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"recipient_emails": schema.SetAttribute{
+				MarkdownDescription: "Emails of the recipients the alias forwards to, resolved to IDs at plan time. " +
+					"Conflicts with `recipient_ids`. Unknown and unverified recipients are errors.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the alias forwards mail.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deletion_mode": schema.StringAttribute{
+				MarkdownDescription: "How the alias is removed on destroy: `deactivate` keeps it but stops forwarding, " +
+					"`delete` soft deletes it so it can be restored, and `forget` deletes it permanently. Defaults to `delete`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("delete"),
+			},
+			"restore_if_deleted": schema.BoolAttribute{
+				MarkdownDescription: "With the `custom` format, restore and adopt a soft-deleted alias with the same address " +
+					"instead of failing to create it, e.g. one left behind by `deletion_mode = \"delete\"`. Only used when " +
+					"the alias is created. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The creation timestamp of the alias.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		}),
+	}
+}
+
+// ValidateConfig checks the configured format and deletion mode, and that at
+// most one of recipient_ids and recipient_emails is set.
+func (r *aliasResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config aliasModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Format.IsNull() && !config.Format.IsUnknown() && !slices.Contains(utils.AliasFormats, config.Format.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("format"),
+			"Invalid Alias Format",
+			"The alias format must be one of "+strings.Join(utils.AliasFormats, ", ")+", got: "+config.Format.ValueString(),
+		)
+	}
+
+	if !config.DeletionMode.IsNull() && !config.DeletionMode.IsUnknown() &&
+		!slices.Contains(utils.AliasDeletionModes, config.DeletionMode.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deletion_mode"),
+			"Invalid Deletion Mode",
+			"deletion_mode must be one of "+strings.Join(utils.AliasDeletionModes, ", ")+", got: "+config.DeletionMode.ValueString(),
+		)
+	}
+
+	if !config.RecipientIds.IsNull() && !config.RecipientEmails.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("recipient_emails"),
+			"Conflicting Recipients",
+			"Only one of recipient_ids and recipient_emails can be set.",
+		)
+	}
+}
+
+// IdentitySchema defines the identity of the resource. Either attribute is
+// enough to import an alias.
+func (r *aliasResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the alias.",
+				OptionalForImport: true,
+			},
+			"email": identityschema.StringAttribute{
+				Description:       "The email address of the alias.",
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *aliasResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = providerData
+}
+
+// ModifyPlan applies the provider defaults to attributes the configuration
+// omits, so the values the alias will get are visible in the plan, resolves
+// the recipients so both their IDs and emails are planned, and checks planned
+// alias creations against the account's limits.
+func (r *aliasResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.providerData == nil {
+		return
+	}
+
+	var config, plan aliasModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creating := req.State.Raw.IsNull()
+	defaults := r.providerData.Defaults
+
+	if creating && config.Domain.IsNull() && defaults.AliasDomain != "" {
+		plan.Domain = types.StringValue(defaults.AliasDomain)
+	}
+
+	if config.Format.IsNull() {
+		if creating {
+			plan.Format = stringValueOrNull(&defaults.AliasFormat)
+		} else {
+			var state aliasModel
+			resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+			plan.Format = state.Format
+		}
+	}
+
+	if creating && plan.Format.ValueString() == "custom" && config.LocalPart.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("local_part"),
+			"Missing Local Part",
+			"local_part must be set when the alias format is custom.",
+		)
+	}
+
+	if config.FromName.IsNull() {
+		plan.FromName = stringValueOrNull(&defaults.FromName)
+	}
+
+	recipientIds := config.RecipientIds
+	if creating && config.RecipientIds.IsNull() && config.RecipientEmails.IsNull() && len(defaults.RecipientIds) > 0 {
+		var diags diag.Diagnostics
+		recipientIds, diags = types.SetValueFrom(ctx, types.StringType, defaults.RecipientIds)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Aliases on an unknown domain or with unknown credentials cannot be
+	// checked until apply.
+	session, err := r.providerData.planSession(ctx, plan.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	// Recipients left out of the configuration keep their prior values.
+	if !recipientIds.IsNull() || !config.RecipientEmails.IsNull() {
+		ids, emails, diags := resolveRecipientSets(ctx, session, recipientIds, config.RecipientEmails)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.RecipientIds = ids
+		plan.RecipientEmails = emails
+	}
+
+	if creating && session != nil && !config.Domain.IsUnknown() {
+		domain := ""
+		if !plan.Domain.IsUnknown() {
+			domain = plan.Domain.ValueString()
+		}
+		if err := session.Quota.ReserveAlias(ctx, domain); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("domain"), "Addy Alias Limit Reached", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *aliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan aliasModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, plan.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resolvePlannedRecipients(ctx, session, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	create := utils.AliasCreate{
+		Domain:      plan.Domain.ValueString(),
+		Format:      plan.Format.ValueString(),
+		LocalPart:   plan.LocalPart.ValueString(),
+		Description: optionalString(utils.AppendMarker(plan.Description.ValueString(), r.providerData.ManagedMarker)),
+	}
+	if !plan.RecipientIds.IsUnknown() {
+		resp.Diagnostics.Append(plan.RecipientIds.ElementsAs(ctx, &create.RecipientIds, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var alias *utils.Alias
+	if plan.RestoreIfDeleted.ValueBool() && create.Format == "custom" && create.Domain != "" {
+		deleted, err := utils.FindDeletedAlias(ctx, session, create.LocalPart, create.Domain)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Search Deleted Aliases", err.Error())
+			return
+		}
+		if deleted != nil {
+			alias, err = utils.RestoreAlias(ctx, session, deleted.ID)
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to Restore Alias", err.Error())
+				return
+			}
+			resp.Diagnostics.AddWarning(
+				"Restored Deleted Alias",
+				fmt.Sprintf("The soft-deleted alias %s (%s) was restored and adopted instead of creating a new one.", alias.Email, alias.ID),
+			)
+
+			// A new alias would be active, so the adopted one is too
+			// unless active is configured.
+			if plan.Active.IsUnknown() && !alias.Active {
+				if err := utils.SetAliasActive(ctx, session, alias.ID, true); err != nil {
+					resp.Diagnostics.AddError("Unable to Activate Alias", err.Error())
+					return
+				}
+				alias.Active = true
+			}
+		}
+	}
+
+	if alias == nil {
+		tflog.Debug(ctx, "Creating alias", map[string]interface{}{
+			"domain": create.Domain,
+			"format": create.Format,
+		})
+
+		alias, err = utils.CreateAliasIdempotent(ctx, session, create)
+		if alias == nil {
+			resp.Diagnostics.AddError("Unable to Create Alias", err.Error())
+			return
+		}
+		if err != nil {
+			// The description still carries the nonce, which apply below
+			// overwrites with the planned one.
+			tflog.Warn(ctx, "Unable to clean up the alias description", map[string]interface{}{
+				"id":    alias.ID,
+				"error": err.Error(),
+			})
+		}
+	}
+
+	// Save the ID, credentials and deletion mode straight away so a failure
+	// below leaves the alias tracked (and tainted) instead of orphaned, and
+	// destroyed the way the plan says.
+	resp.Diagnostics.Append(setCreatedID(ctx, &resp.State, alias.ID, plan.credentialsModel)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_mode"), plan.DeletionMode)...)
+
+	alias, err = r.apply(ctx, session, alias, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Configure Alias", err.Error())
+		return
+	}
+
+	r.setModel(&plan, alias)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newAliasIdentity(alias))...)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *aliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state aliasModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	alias, err := utils.GetAlias(ctx, session, state.ID.ValueString())
+	if utils.IsNotFound(err) || (err == nil && alias.DeletedAt != nil) {
+		tflog.Warn(ctx, "Alias no longer exists, removing from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Alias", err.Error())
+		return
+	}
+
+	r.setModel(&state, alias)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newAliasIdentity(alias))...)
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *aliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state aliasModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, plan.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resolvePlannedRecipients(ctx, session, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, err := utils.GetAlias(ctx, session, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Alias", err.Error())
+		return
+	}
+
+	alias, err = r.apply(ctx, session, alias, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Update Alias", err.Error())
+		return
+	}
+
+	r.setModel(&plan, alias)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newAliasIdentity(alias))...)
+	}
+}
+
+// Delete deactivates, soft deletes or forgets the alias according to
+// deletion_mode and removes the Terraform state on success.
+func (r *aliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state aliasModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	mode := state.DeletionMode.ValueString()
+	if mode == "" {
+		mode = "delete"
+	}
+
+	tflog.Debug(ctx, "Removing alias", map[string]interface{}{
+		"id":   state.ID.ValueString(),
+		"mode": mode,
+	})
+
+	err = utils.RemoveAlias(ctx, session, state.ID.ValueString(), mode)
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Delete Alias", err.Error())
+	}
+}
+
+// apply brings the server-side alias in line with plan, only calling the
+// endpoints whose values differ, and returns the resulting alias.
+func (r *aliasResource) apply(ctx context.Context, session *utils.Session, alias *utils.Alias, plan aliasModel) (*utils.Alias, error) {
+	update := utils.AliasUpdate{
+		Description: optionalString(utils.AppendMarker(plan.Description.ValueString(), r.providerData.ManagedMarker)),
+		FromName:    plan.FromName.ValueStringPointer(),
+	}
+
+	if !equalStrings(update.Description, alias.Description) || !equalStrings(update.FromName, alias.FromName) {
+		updated, err := utils.UpdateAlias(ctx, session, alias.ID, update)
+		if err != nil {
+			return nil, err
+		}
+		alias = updated
+	}
+
+	// Recipients are only managed when known in the plan, leaving them to
+	// addy_alias_recipients otherwise.
+	if !plan.RecipientIds.IsUnknown() && !plan.RecipientIds.IsNull() {
+		var recipientIds []string
+		if diags := plan.RecipientIds.ElementsAs(ctx, &recipientIds, false); diags.HasError() {
+			return nil, fmt.Errorf("invalid recipient_ids: %v", diags)
+		}
+
+		current := make([]string, 0, len(alias.Recipients))
+		for _, recipient := range alias.Recipients {
+			current = append(current, recipient.ID)
+		}
+		slices.Sort(recipientIds)
+		slices.Sort(current)
+
+		if !slices.Equal(recipientIds, current) {
+			updated, err := utils.SetAliasRecipients(ctx, session, alias.ID, recipientIds)
+			if err != nil {
+				return nil, err
+			}
+			alias = updated
+		}
+	}
+
+	if !plan.Active.IsUnknown() && plan.Active.ValueBool() != alias.Active {
+		if err := utils.SetAliasActive(ctx, session, alias.ID, plan.Active.ValueBool()); err != nil {
+			return nil, err
+		}
+		alias.Active = plan.Active.ValueBool()
+	}
+
+	return alias, nil
+}
+
+// resolvePlannedRecipients resolves the planned recipient emails to IDs when
+// plan could not, e.g. with unknown credentials or a recipient created in the
+// same apply.
+func resolvePlannedRecipients(ctx context.Context, session *utils.Session, plan *aliasModel) diag.Diagnostics {
+	if !plan.RecipientIds.IsUnknown() || plan.RecipientEmails.IsNull() || plan.RecipientEmails.IsUnknown() {
+		return nil
+	}
+
+	var emails []string
+	diags := plan.RecipientEmails.ElementsAs(ctx, &emails, false)
+	if diags.HasError() {
+		return diags
+	}
+	ids, err := recipientIDs(ctx, session, emails)
+	if err != nil {
+		diags.AddAttributeError(path.Root("recipient_emails"), "Unable to Resolve Recipient Emails", err.Error())
+		return diags
+	}
+
+	plan.RecipientIds, diags = types.SetValueFrom(ctx, types.StringType, ids)
+	return diags
+}
+
+// setModel copies the server-side alias into model, stripping the managed
+// marker from the description.
+func (r *aliasResource) setModel(model *aliasModel, alias *utils.Alias) {
+	model.set(alias, r.providerData.ManagedMarker)
+}
+
+func (model *aliasModel) set(alias *utils.Alias, marker string) {
+	description := ""
+	if alias.Description != nil {
+		description = utils.StripMarker(*alias.Description, marker)
+	}
+
+	// Building sets of strings cannot fail.
+	ids, emails, _ := recipientSets(context.Background(), alias.Recipients)
+	model.RecipientIds = ids
+	// Keep the configured casing of the recipient emails.
+	model.RecipientEmails = keepEmailCase(context.Background(), model.RecipientEmails, emails)
+
+	// The API does not report the format, so keep the planned one.
+	if model.Format.IsUnknown() {
+		model.Format = types.StringNull()
+	}
+
+	model.ID = types.StringValue(alias.ID)
+	model.Email = types.StringValue(alias.Email)
+	model.Domain = types.StringValue(alias.Domain)
+	model.LocalPart = types.StringValue(alias.LocalPart)
+	model.Description = stringValueOrNull(optionalString(description))
+	model.FromName = stringValueOrNull(alias.FromName)
+	model.Active = types.BoolValue(alias.Active)
+	// Imported and listed aliases get the defaults of the settings that
+	// only apply to create and destroy.
+	if model.DeletionMode.IsNull() {
+		model.DeletionMode = types.StringValue("delete")
+	}
+	if model.RestoreIfDeleted.IsNull() {
+		model.RestoreIfDeleted = types.BoolValue(false)
+	}
+	model.CreatedAt = types.StringValue(alias.CreatedAt)
+}
+
+// ImportState imports an alias by ID or email, given either as the import ID
+// or through the identity attributes. Emails are looked up with the
+// provider's credentials, as import gets no resource configuration.
+func (r *aliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.providerData.importByKey(ctx, req, resp, importKey{
+		noun:      "Alias",
+		attribute: "email",
+		name:      "email",
+		find: func(ctx context.Context, session *utils.Session, key string) (string, error) {
+			alias, err := utils.FindAlias(ctx, session, key)
+			if err != nil {
+				return "", err
+			}
+			return alias.ID, nil
+		},
+	})
+}
+
+func newAliasIdentity(alias *utils.Alias) aliasIdentityModel {
+	return aliasIdentityModel{
+		ID:    types.StringValue(alias.ID),
+		Email: types.StringValue(alias.Email),
+	}
+}
@@ -0,0 +1,245 @@
+package resource
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// aliasHostModel maps the attributes shared by custom domains and usernames,
+// which both host aliases and are configured through parallel endpoints.
+type aliasHostModel struct {
+	Description           types.String `tfsdk:"description"`
+	FromName              types.String `tfsdk:"from_name"`
+	AutoCreateRegex       types.String `tfsdk:"auto_create_regex"`
+	DefaultRecipientID    types.String `tfsdk:"default_recipient_id"`
+	DefaultRecipientEmail types.String `tfsdk:"default_recipient_email"`
+	Active                types.Bool   `tfsdk:"active"`
+	CatchAll              types.Bool   `tfsdk:"catch_all"`
+}
+
+// aliasHost is the server-side state of a domain or username along with the
+// calls that change it. Each call updates the state it returns.
+type aliasHost interface {
+	settings() aliasHostSettings
+	update(ctx context.Context, session *utils.Session, update utils.DomainUpdate) error
+	setDefaultRecipient(ctx context.Context, session *utils.Session, recipientID *string) error
+	setActive(ctx context.Context, session *utils.Session, active bool) error
+	setCatchAll(ctx context.Context, session *utils.Session, catchAll bool) error
+}
+
+// aliasHostSettings holds the server-side values of aliasHostModel.
+type aliasHostSettings struct {
+	Description      *string
+	FromName         *string
+	AutoCreateRegex  *string
+	DefaultRecipient *utils.Recipient
+	Active           bool
+	CatchAll         bool
+}
+
+// withAliasHostAttributes adds the aliasHostModel attributes to a resource
+// schema. noun names the resource, e.g. "domain".
+func withAliasHostAttributes(noun string, attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["description"] = schema.StringAttribute{
+		MarkdownDescription: "A description of the " + noun + ".",
+		Optional:            true,
+	}
+	attributes["from_name"] = schema.StringAttribute{
+		MarkdownDescription: "The from name used for aliases on the " + noun + ".",
+		Optional:            true,
+	}
+	attributes["auto_create_regex"] = schema.StringAttribute{
+		MarkdownDescription: "Regular expression that aliases must match to be created on the fly when catch-all is disabled.",
+		Optional:            true,
+	}
+	attributes["default_recipient_id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the recipient that receives mail for the " + noun + "'s aliases by default. " +
+			"Leaving both this and `default_recipient_email` unset uses the account's default recipient.",
+		Optional: true,
+		Computed: true,
+	}
+	attributes["default_recipient_email"] = schema.StringAttribute{
+		MarkdownDescription: "The email of the default recipient, resolved to its ID at plan time, or at apply if the " +
+			"recipient does not exist or is unverified yet. Conflicts with `default_recipient_id`.",
+		Optional: true,
+		Computed: true,
+	}
+	attributes["active"] = schema.BoolAttribute{
+		MarkdownDescription: "Whether the " + noun + " is active.",
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["catch_all"] = schema.BoolAttribute{
+		MarkdownDescription: "Whether aliases are created automatically when mail is received for them.",
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+	return attributes
+}
+
+// validate rejects setting the default recipient by both ID and email.
+func (config aliasHostModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !config.DefaultRecipientID.IsNull() && !config.DefaultRecipientEmail.IsNull() {
+		diags.AddAttributeError(
+			path.Root("default_recipient_email"),
+			"Conflicting Default Recipient",
+			"Only one of default_recipient_id and default_recipient_email can be set.",
+		)
+	}
+	return diags
+}
+
+// plan checks auto_create_regex against the server version and resolves the
+// default recipient so both its ID and email are planned. A nil session,
+// i.e. unknown credentials, leaves the checks to apply.
+func (config aliasHostModel) plan(ctx context.Context, session *utils.Session, resp *resource.ModifyPlanResponse) {
+	if !config.AutoCreateRegex.IsNull() && session != nil {
+		if err := session.Version.Require(ctx, utils.FeatureAutoCreateRegex); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("auto_create_regex"), "Unsupported Addy Version", err.Error())
+		}
+	}
+
+	id, email, err := resolveDefaultRecipient(ctx, session, config.DefaultRecipientID, config.DefaultRecipientEmail)
+	if err != nil {
+		attribute := path.Root("default_recipient_email")
+		if config.DefaultRecipientEmail.IsNull() {
+			attribute = path.Root("default_recipient_id")
+		}
+		resp.Diagnostics.AddAttributeError(attribute, "Unable to Resolve Default Recipient", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("default_recipient_id"), id)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("default_recipient_email"), email)...)
+}
+
+// apply brings host in line with plan, only calling the endpoints whose
+// values differ. marker is appended to the description.
+func (plan aliasHostModel) apply(ctx context.Context, session *utils.Session, host aliasHost, marker string) error {
+	current := host.settings()
+	update := utils.DomainUpdate{
+		Description:     optionalString(utils.AppendMarker(plan.Description.ValueString(), marker)),
+		FromName:        plan.FromName.ValueStringPointer(),
+		AutoCreateRegex: plan.AutoCreateRegex.ValueStringPointer(),
+	}
+
+	if !equalStrings(update.Description, current.Description) ||
+		!equalStrings(update.FromName, current.FromName) ||
+		!equalStrings(update.AutoCreateRegex, current.AutoCreateRegex) {
+		if err := host.update(ctx, session, update); err != nil {
+			return err
+		}
+	}
+
+	// The ID is still unknown when plan could not resolve the email.
+	recipientID := plan.DefaultRecipientID.ValueStringPointer()
+	if plan.DefaultRecipientID.IsUnknown() {
+		ids, err := recipientIDs(ctx, session, []string{plan.DefaultRecipientEmail.ValueString()})
+		if err != nil {
+			return err
+		}
+		recipientID = &ids[0]
+	}
+
+	var currentRecipientID *string
+	if current.DefaultRecipient != nil {
+		currentRecipientID = &current.DefaultRecipient.ID
+	}
+	if !equalStrings(recipientID, currentRecipientID) {
+		if err := host.setDefaultRecipient(ctx, session, recipientID); err != nil {
+			return err
+		}
+	}
+
+	if !plan.Active.IsUnknown() && plan.Active.ValueBool() != current.Active {
+		if err := host.setActive(ctx, session, plan.Active.ValueBool()); err != nil {
+			return err
+		}
+	}
+
+	if !plan.CatchAll.IsUnknown() && plan.CatchAll.ValueBool() != current.CatchAll {
+		if err := host.setCatchAll(ctx, session, plan.CatchAll.ValueBool()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// set copies the server-side settings into model, stripping the managed
+// marker from the description and keeping the configured casing of the
+// default recipient's email.
+func (model *aliasHostModel) set(settings aliasHostSettings, marker string) {
+	prior := model.DefaultRecipientEmail
+
+	description := ""
+	if settings.Description != nil {
+		description = utils.StripMarker(*settings.Description, marker)
+	}
+
+	model.Description = stringValueOrNull(optionalString(description))
+	model.FromName = stringValueOrNull(settings.FromName)
+	model.AutoCreateRegex = stringValueOrNull(settings.AutoCreateRegex)
+	model.DefaultRecipientID = types.StringNull()
+	model.DefaultRecipientEmail = types.StringNull()
+	if settings.DefaultRecipient != nil {
+		model.DefaultRecipientID = types.StringValue(settings.DefaultRecipient.ID)
+		if !strings.EqualFold(prior.ValueString(), settings.DefaultRecipient.Email) {
+			model.DefaultRecipientEmail = types.StringValue(settings.DefaultRecipient.Email)
+		} else {
+			model.DefaultRecipientEmail = prior
+		}
+	}
+	model.Active = types.BoolValue(settings.Active)
+	model.CatchAll = types.BoolValue(settings.CatchAll)
+}
+
+// resolveDefaultRecipient resolves whichever of the configured default
+// recipient ID and email is set into the other during plan. Unknown values
+// stay unknown and neither being set means the account default. A nil
+// session, i.e. unknown credentials, leaves the counterpart unknown, and so
+// does the email of a recipient that does not exist or is unverified yet.
+func resolveDefaultRecipient(ctx context.Context, session *utils.Session, id types.String, email types.String) (types.String, types.String, error) {
+	switch {
+	case id.IsUnknown() || email.IsUnknown():
+		return types.StringUnknown(), types.StringUnknown(), nil
+	case session == nil && !email.IsNull():
+		return types.StringUnknown(), email, nil
+	case session == nil && !id.IsNull():
+		return id, types.StringUnknown(), nil
+	case !email.IsNull():
+		recipients, err := utils.RecipientsByEmail(ctx, session, []string{email.ValueString()})
+		if utils.IsUnresolvedRecipient(err) {
+			return types.StringUnknown(), email, nil
+		}
+		if err != nil {
+			return types.StringUnknown(), types.StringUnknown(), err
+		}
+		return types.StringValue(recipients[0].ID), email, nil
+	case !id.IsNull():
+		recipients, err := utils.RecipientsByID(ctx, session, []string{id.ValueString()})
+		if err != nil {
+			return types.StringUnknown(), types.StringUnknown(), err
+		}
+		return id, types.StringValue(recipients[0].Email), nil
+	default:
+		return types.StringNull(), types.StringNull(), nil
+	}
+}
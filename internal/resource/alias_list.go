@@ -0,0 +1,147 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &aliasListResource{}
+	_ list.ListResourceWithConfigure = &aliasListResource{}
+)
+
+// NewAliasListResource is a helper function to simplify the provider implementation.
+func NewAliasListResource() list.ListResource {
+	return &aliasListResource{}
+}
+
+// aliasListResource lists existing aliases for terraform query.
+type aliasListResource struct {
+	providerData *ResourceData
+}
+
+type aliasListModel struct {
+	credentialsModel
+	Search  types.String `tfsdk:"search"`
+	Domain  types.String `tfsdk:"domain"`
+	Active  types.Bool   `tfsdk:"active"`
+	Managed types.Bool   `tfsdk:"managed"`
+}
+
+// Metadata returns the managed resource type name being listed.
+func (r *aliasListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alias"
+}
+
+// ListResourceConfigSchema defines the filters accepted by list blocks.
+func (r *aliasListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the aliases in the account. Deleted aliases are not listed.",
+
+		Attributes: withListCredentials(map[string]schema.Attribute{
+			"search": schema.StringAttribute{
+				MarkdownDescription: "Only list aliases whose email or description contains this term.",
+				Optional:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Only list aliases on this domain.",
+				Optional:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Only list aliases that are active (`true`) or inactive (`false`).",
+				Optional:            true,
+			},
+			"managed": schema.BoolAttribute{
+				MarkdownDescription: "Only list aliases that carry (`true`) or lack (`false`) the provider's managed marker.",
+				Optional:            true,
+			},
+		}),
+	}
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *aliasListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *ResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = providerData
+}
+
+// List streams the aliases matching the configured filters.
+func (r *aliasListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config aliasListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Listing aliases")
+
+	session, err := r.providerData.session(ctx, config.credentialsModel)
+	if err != nil {
+		diags.AddError("Unable to Create Addy API Session", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	aliases, err := utils.ListAliases(ctx, session, utils.AliasFilter{
+		Search: config.Search.ValueString(),
+		Active: config.Active.ValueBoolPointer(),
+	})
+	if err != nil {
+		diags.AddError("Unable to List Aliases", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, alias := range aliases {
+			if !config.Domain.IsNull() && !strings.EqualFold(config.Domain.ValueString(), alias.Domain) {
+				continue
+			}
+			if !config.Managed.IsNull() && config.Managed.ValueBool() != managed(alias.Description, r.providerData.ManagedMarker) {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = alias.Email
+			result.Diagnostics.Append(result.Identity.Set(ctx, newAliasIdentity(&alias))...)
+
+			if req.IncludeResource {
+				var model aliasModel
+				model.set(&alias, r.providerData.ManagedMarker)
+				result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
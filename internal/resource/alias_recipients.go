@@ -0,0 +1,286 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &aliasRecipientsResource{}
+	_ resource.ResourceWithConfigure      = &aliasRecipientsResource{}
+	_ resource.ResourceWithImportState    = &aliasRecipientsResource{}
+	_ resource.ResourceWithModifyPlan     = &aliasRecipientsResource{}
+	_ resource.ResourceWithValidateConfig = &aliasRecipientsResource{}
+)
+
+// NewAliasRecipientsResource is a helper function to simplify the provider implementation.
+func NewAliasRecipientsResource() resource.Resource {
+	return &aliasRecipientsResource{}
+}
+
+// aliasRecipientsResource authoritatively manages the recipients of an alias.
+type aliasRecipientsResource struct {
+	providerData *ResourceData
+}
+
+type aliasRecipientsModel struct {
+	credentialsModel
+	ID              types.String `tfsdk:"id"`
+	AliasID         types.String `tfsdk:"alias_id"`
+	RecipientIds    types.Set    `tfsdk:"recipient_ids"`
+	RecipientEmails types.Set    `tfsdk:"recipient_emails"`
+}
+
+// Metadata returns the resource type name.
+func (r *aliasRecipientsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alias_recipients"
+}
+
+// Schema defines the schema for the resource.
+func (r *aliasRecipientsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages the full set of recipients attached to an alias. " +
+			"Recipients attached outside Terraform show as drift and are detached on the next apply. " +
+			"Do not combine with `recipient_ids` on the alias itself. Destroying it detaches every recipient, " +
+			"so the alias falls back to the account's default recipient.",
+
+		Attributes: withCredentials(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the alias.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"alias_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the alias whose recipients are managed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"recipient_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of every recipient attached to the alias. An empty set uses the account's default recipient. " +
+					"Exactly one of `recipient_ids` and `recipient_emails` must be set.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"recipient_emails": schema.SetAttribute{
+				MarkdownDescription: "The emails of every recipient attached to the alias, resolved to IDs at plan time. " +
+					"Unknown and unverified recipients are errors.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+		}),
+	}
+}
+
+// ValidateConfig requires exactly one of recipient_ids and recipient_emails.
+func (r *aliasRecipientsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config aliasRecipientsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.RecipientIds.IsNull() == config.RecipientEmails.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("recipient_ids"),
+			"Invalid Recipient Selection",
+			"Exactly one of recipient_ids and recipient_emails must be set.",
+		)
+	}
+}
+
+// ModifyPlan resolves the configured recipient emails to IDs, or IDs to
+// emails, so both forms are shown in the plan.
+func (r *aliasRecipientsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.providerData == nil {
+		return
+	}
+
+	var config, plan aliasRecipientsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.planSession(ctx, plan.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	ids, emails, diags := resolveRecipientSets(ctx, session, config.RecipientIds, config.RecipientEmails)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("recipient_ids"), ids)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("recipient_emails"), emails)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *aliasRecipientsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = providerData
+}
+
+// Create attaches the planned recipients, replacing any already attached.
+func (r *aliasRecipientsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan aliasRecipientsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, err := r.apply(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Set Alias Recipients", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setAliasRecipientsModel(ctx, &plan, alias)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *aliasRecipientsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state aliasRecipientsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	alias, err := utils.GetAlias(ctx, session, state.AliasID.ValueString())
+	if utils.IsNotFound(err) || (err == nil && alias.DeletedAt != nil) {
+		tflog.Warn(ctx, "Alias no longer exists, removing its recipients from state", map[string]interface{}{
+			"alias_id": state.AliasID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Alias", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setAliasRecipientsModel(ctx, &state, alias)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update replaces the attached recipients with the planned set.
+func (r *aliasRecipientsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan aliasRecipientsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, err := r.apply(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Set Alias Recipients", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setAliasRecipientsModel(ctx, &plan, alias)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete detaches every recipient from the alias.
+func (r *aliasRecipientsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state aliasRecipientsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	_, err = utils.SetAliasRecipients(ctx, session, state.AliasID.ValueString(), nil)
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Detach Alias Recipients", err.Error())
+	}
+}
+
+// ImportState imports the recipients of an alias by the alias ID.
+func (r *aliasRecipientsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("alias_id"), req.ID)...)
+}
+
+// apply replaces the alias's recipients with those in plan in one call.
+func (r *aliasRecipientsResource) apply(ctx context.Context, plan aliasRecipientsModel) (*utils.Alias, error) {
+	session, err := r.providerData.session(ctx, plan.credentialsModel)
+	if err != nil {
+		return nil, err
+	}
+
+	// The IDs are still unknown when plan could not resolve the emails.
+	var recipientIds []string
+	if plan.RecipientIds.IsUnknown() {
+		var emails []string
+		if diags := plan.RecipientEmails.ElementsAs(ctx, &emails, false); diags.HasError() {
+			return nil, fmt.Errorf("invalid recipient_emails: %v", diags)
+		}
+		if recipientIds, err = recipientIDs(ctx, session, emails); err != nil {
+			return nil, fmt.Errorf("unable to resolve recipient_emails: %w", err)
+		}
+	} else if diags := plan.RecipientIds.ElementsAs(ctx, &recipientIds, false); diags.HasError() {
+		return nil, fmt.Errorf("invalid recipient_ids: %v", diags)
+	}
+
+	tflog.Debug(ctx, "Setting alias recipients", map[string]interface{}{
+		"alias_id":      plan.AliasID.ValueString(),
+		"recipient_ids": recipientIds,
+	})
+
+	return utils.SetAliasRecipients(ctx, session, plan.AliasID.ValueString(), recipientIds)
+}
+
+func setAliasRecipientsModel(ctx context.Context, model *aliasRecipientsModel, alias *utils.Alias) diag.Diagnostics {
+	ids, emails, diags := recipientSets(ctx, alias.Recipients)
+	model.ID = types.StringValue(alias.ID)
+	model.AliasID = types.StringValue(alias.ID)
+	model.RecipientIds = ids
+	model.RecipientEmails = keepEmailCase(ctx, model.RecipientEmails, emails)
+	return diags
+}
@@ -2,14 +2,27 @@ package resource
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &domainResource{}
+	_ resource.Resource                   = &domainResource{}
+	_ resource.ResourceWithConfigure      = &domainResource{}
+	_ resource.ResourceWithModifyPlan     = &domainResource{}
+	_ resource.ResourceWithImportState    = &domainResource{}
+	_ resource.ResourceWithIdentity       = &domainResource{}
+	_ resource.ResourceWithValidateConfig = &domainResource{}
 )
 
 // NewdomainResource is a helper function to simplify the provider implementation.
@@ -18,7 +31,26 @@ func NewDomainResource() resource.Resource {
 }
 
 // domainResource is the resource implementation.
-type domainResource struct{}
+type domainResource struct {
+	providerData *ResourceData
+}
+
+type domainModel struct {
+	credentialsModel
+	aliasHostModel
+	ID                      types.String `tfsdk:"id"`
+	Domain                  types.String `tfsdk:"domain"`
+	DomainVerifiedAt        types.String `tfsdk:"domain_verified_at"`
+	DomainMxValidatedAt     types.String `tfsdk:"domain_mx_validated_at"`
+	DomainSendingVerifiedAt types.String `tfsdk:"domain_sending_verified_at"`
+	CreatedAt               types.String `tfsdk:"created_at"`
+}
+
+// domainIdentityModel identifies a domain by its ID and its domain name.
+type domainIdentityModel struct {
+	ID     types.String `tfsdk:"id"`
+	Domain types.String `tfsdk:"domain"`
+}
 
 // Metadata returns the resource type name.
 func (r *domainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -27,25 +59,342 @@ func (r *domainResource) Metadata(_ context.Context, req resource.MetadataReques
 
 // Schema defines the schema for the resource.
 func (r *domainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a custom domain. The domain's verification TXT record must exist before it can be added.",
+
+		Attributes: withCredentials(withAliasHostAttributes("domain", map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the domain.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name. Changing this creates a new domain.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_verified_at": schema.StringAttribute{
+				MarkdownDescription: "When domain ownership was verified. Null if not verified.",
+				Computed:            true,
+			},
+			"domain_mx_validated_at": schema.StringAttribute{
+				MarkdownDescription: "When the MX records were validated. Null if not validated.",
+				Computed:            true,
+			},
+			"domain_sending_verified_at": schema.StringAttribute{
+				MarkdownDescription: "When the domain was verified for sending. Null if not verified.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The creation timestamp of the domain.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		})),
+	}
+}
+
+// IdentitySchema defines the identity of the resource. Either attribute is
+// enough to import a domain.
+func (r *domainResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the domain.",
+				OptionalForImport: true,
+			},
+			"domain": identityschema.StringAttribute{
+				Description:       "The domain name.",
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *domainResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = providerData
+}
+
+// ValidateConfig rejects setting the default recipient by both ID and email.
+func (r *domainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config domainModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(config.validate()...)
+}
+
+// ModifyPlan checks planned domain creations against the account's limits
+// and attributes against the server version, and resolves the default
+// recipient so both its ID and email are planned.
+func (r *domainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.providerData == nil {
+		return
+	}
+
+	var config, plan domainModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.planSession(ctx, plan.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	reserveCreation(ctx, req, resp, session, utils.QuotaDomains, "domain", "Domain")
+	config.aliasHostModel.plan(ctx, session, resp)
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	panic("not implemented")
+	var plan domainModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, plan.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Creating domain", map[string]interface{}{
+		"domain": plan.Domain.ValueString(),
+	})
+
+	domain, err := utils.CreateDomain(ctx, session, plan.Domain.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Domain", err.Error())
+		return
+	}
+
+	// Save the ID and credentials straight away so a failure below
+	// leaves the domain tracked (and tainted) instead of orphaned.
+	resp.Diagnostics.Append(setCreatedID(ctx, &resp.State, domain.ID, plan.credentialsModel)...)
+
+	domain, err = r.apply(ctx, session, domain, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Configure Domain", err.Error())
+		return
+	}
+
+	r.setModel(&plan, domain)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newDomainIdentity(domain))...)
+	}
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	panic("not implemented")
+	var state domainModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	domain, err := utils.GetDomain(ctx, session, state.ID.ValueString())
+	if utils.IsNotFound(err) {
+		tflog.Warn(ctx, "Domain no longer exists, removing from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Domain", err.Error())
+		return
+	}
+
+	r.setModel(&state, domain)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newDomainIdentity(domain))...)
+	}
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	panic("not implemented")
+	var plan, state domainModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, plan.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	domain, err := utils.GetDomain(ctx, session, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Domain", err.Error())
+		return
+	}
+
+	domain, err = r.apply(ctx, session, domain, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Update Domain", err.Error())
+		return
+	}
+
+	r.setModel(&plan, domain)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newDomainIdentity(domain))...)
+	}
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *domainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	panic("not implemented")
+	var state domainModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	err = utils.DeleteDomain(ctx, session, state.ID.ValueString())
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Delete Domain", err.Error())
+	}
+}
+
+// ImportState imports a domain by ID or domain name, given either as the
+// import ID or through the identity attributes. Names are looked up with
+// the provider's credentials, as import gets no resource configuration.
+func (r *domainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.providerData.importByKey(ctx, req, resp, importKey{
+		noun:      "Domain",
+		attribute: "domain",
+		name:      "domain name",
+		find: func(ctx context.Context, session *utils.Session, key string) (string, error) {
+			domain, err := utils.FindDomain(ctx, session, key)
+			if err != nil {
+				return "", err
+			}
+			return domain.ID, nil
+		},
+	})
+}
+
+// apply brings the server-side domain in line with plan and returns the
+// resulting domain.
+func (r *domainResource) apply(ctx context.Context, session *utils.Session, domain *utils.Domain, plan domainModel) (*utils.Domain, error) {
+	host := &domainHost{domain: domain}
+	if err := plan.aliasHostModel.apply(ctx, session, host, r.providerData.ManagedMarker); err != nil {
+		return nil, err
+	}
+	return host.domain, nil
+}
+
+// setModel copies the server-side domain into model, stripping the managed
+// marker from the description.
+func (r *domainResource) setModel(model *domainModel, domain *utils.Domain) {
+	model.set(domain, r.providerData.ManagedMarker)
+}
+
+func (model *domainModel) set(domain *utils.Domain, marker string) {
+	model.ID = types.StringValue(domain.ID)
+	model.Domain = types.StringValue(domain.Domain)
+	model.aliasHostModel.set((&domainHost{domain: domain}).settings(), marker)
+	model.DomainVerifiedAt = types.StringPointerValue(domain.DomainVerifiedAt)
+	model.DomainMxValidatedAt = types.StringPointerValue(domain.DomainMxValidatedAt)
+	model.DomainSendingVerifiedAt = types.StringPointerValue(domain.DomainSendingVerifiedAt)
+	model.CreatedAt = types.StringValue(domain.CreatedAt)
+}
+
+func newDomainIdentity(domain *utils.Domain) domainIdentityModel {
+	return domainIdentityModel{
+		ID:     types.StringValue(domain.ID),
+		Domain: types.StringValue(domain.Domain),
+	}
+}
+
+// domainHost applies aliasHostModel to a domain.
+type domainHost struct {
+	domain *utils.Domain
+}
+
+func (h *domainHost) settings() aliasHostSettings {
+	return aliasHostSettings{
+		Description:      h.domain.Description,
+		FromName:         h.domain.FromName,
+		AutoCreateRegex:  h.domain.AutoCreateRegex,
+		DefaultRecipient: h.domain.DefaultRecipient,
+		Active:           h.domain.Active,
+		CatchAll:         h.domain.CatchAll,
+	}
+}
+
+func (h *domainHost) update(ctx context.Context, session *utils.Session, update utils.DomainUpdate) error {
+	domain, err := utils.UpdateDomain(ctx, session, h.domain.ID, update)
+	if err == nil {
+		h.domain = domain
+	}
+	return err
+}
+
+func (h *domainHost) setDefaultRecipient(ctx context.Context, session *utils.Session, recipientID *string) error {
+	domain, err := utils.SetDomainDefaultRecipient(ctx, session, h.domain.ID, recipientID)
+	if err == nil {
+		h.domain = domain
+	}
+	return err
+}
+
+func (h *domainHost) setActive(ctx context.Context, session *utils.Session, active bool) error {
+	err := utils.SetDomainActive(ctx, session, h.domain.ID, active)
+	if err == nil {
+		h.domain.Active = active
+	}
+	return err
+}
+
+func (h *domainHost) setCatchAll(ctx context.Context, session *utils.Session, catchAll bool) error {
+	err := utils.SetDomainCatchAll(ctx, session, h.domain.ID, catchAll)
+	if err == nil {
+		h.domain.CatchAll = catchAll
+	}
+	return err
 }
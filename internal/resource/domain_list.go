@@ -0,0 +1,133 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &domainListResource{}
+	_ list.ListResourceWithConfigure = &domainListResource{}
+)
+
+// NewDomainListResource is a helper function to simplify the provider implementation.
+func NewDomainListResource() list.ListResource {
+	return &domainListResource{}
+}
+
+// domainListResource lists existing domains for terraform query.
+type domainListResource struct {
+	providerData *ResourceData
+}
+
+type domainListModel struct {
+	credentialsModel
+	Active  types.Bool `tfsdk:"active"`
+	Managed types.Bool `tfsdk:"managed"`
+}
+
+// Metadata returns the managed resource type name being listed.
+func (r *domainListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain"
+}
+
+// ListResourceConfigSchema defines the filters accepted by list blocks.
+func (r *domainListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the custom domains in the account.",
+
+		Attributes: withListCredentials(map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Only list domains that are active (`true`) or inactive (`false`).",
+				Optional:            true,
+			},
+			"managed": schema.BoolAttribute{
+				MarkdownDescription: "Only list domains that carry (`true`) or lack (`false`) the provider's managed marker.",
+				Optional:            true,
+			},
+		}),
+	}
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *domainListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *ResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = providerData
+}
+
+// List streams the domains matching the configured filters.
+func (r *domainListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config domainListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Listing domains")
+
+	session, err := r.providerData.session(ctx, config.credentialsModel)
+	if err != nil {
+		diags.AddError("Unable to Create Addy API Session", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	domains, err := utils.ListDomains(ctx, session)
+	if err != nil {
+		diags.AddError("Unable to List Domains", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, domain := range domains {
+			if !config.Active.IsNull() && config.Active.ValueBool() != domain.Active {
+				continue
+			}
+			if !config.Managed.IsNull() && config.Managed.ValueBool() != managed(domain.Description, r.providerData.ManagedMarker) {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = domain.Domain
+			result.Diagnostics.Append(result.Identity.Set(ctx, newDomainIdentity(&domain))...)
+
+			if req.IncludeResource {
+				var model domainModel
+				model.set(&domain, r.providerData.ManagedMarker)
+				result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
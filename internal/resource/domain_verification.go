@@ -0,0 +1,337 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &domainVerificationResource{}
+	_ resource.ResourceWithConfigure      = &domainVerificationResource{}
+	_ resource.ResourceWithValidateConfig = &domainVerificationResource{}
+)
+
+const (
+	// domainCheckMinInterval and domainCheckMaxInterval bound the backoff
+	// between domain record checks.
+	domainCheckMinInterval = 5 * time.Second
+	domainCheckMaxInterval = time.Minute
+
+	// domainVerificationTimeout is the default timeout. Addy re-checks the
+	// MX records of unvalidated domains once a day, so the wait covers one
+	// scheduled check.
+	domainVerificationTimeout = "25h"
+)
+
+// NewDomainVerificationResource is a helper function to simplify the provider implementation.
+func NewDomainVerificationResource() resource.Resource {
+	return &domainVerificationResource{}
+}
+
+// domainVerificationResource waits for a domain's DNS records to verify.
+type domainVerificationResource struct {
+	providerData *ResourceData
+}
+
+type domainVerificationModel struct {
+	credentialsModel
+	ID                      types.String `tfsdk:"id"`
+	DomainID                types.String `tfsdk:"domain_id"`
+	VerifySending           types.Bool   `tfsdk:"verify_sending"`
+	Timeout                 types.String `tfsdk:"timeout"`
+	DomainVerifiedAt        types.String `tfsdk:"domain_verified_at"`
+	DomainMxValidatedAt     types.String `tfsdk:"domain_mx_validated_at"`
+	DomainSendingVerifiedAt types.String `tfsdk:"domain_sending_verified_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *domainVerificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_verification"
+}
+
+// Schema defines the schema for the resource.
+func (r *domainVerificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Waits for a custom domain's DNS records to verify. Create polls the domain with backoff until it is " +
+			"verified for receiving, and optionally sending, or the timeout expires. Addy checks the records for " +
+			"receiving on its own schedule; with `verify_sending` the sending record check is also triggered on each poll. " +
+			"Make it depend on the DNS records so resources on the domain are only created once mail will flow. " +
+			"Destroying it does not change the domain.",
+
+		Attributes: withCredentials(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the domain.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the domain to wait for. Changing this waits again.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"verify_sending": schema.BoolAttribute{
+				MarkdownDescription: "Also wait for the SPF, DKIM and DMARC records to verify the domain for sending. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait, as a Go duration such as `30m`. Defaults to `25h`, as Addy only re-checks the MX records once a day.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(domainVerificationTimeout),
+			},
+			"domain_verified_at": schema.StringAttribute{
+				MarkdownDescription: "When domain ownership was verified.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_mx_validated_at": schema.StringAttribute{
+				MarkdownDescription: "When the MX records were validated.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_sending_verified_at": schema.StringAttribute{
+				MarkdownDescription: "When the domain was verified for sending. Null unless verified.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		}),
+	}
+}
+
+// ValidateConfig checks that timeout is a valid duration.
+func (r *domainVerificationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config domainVerificationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Timeout.IsNull() || config.Timeout.IsUnknown() {
+		return
+	}
+	if timeout, err := time.ParseDuration(config.Timeout.ValueString()); err != nil || timeout <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeout"),
+			"Invalid Timeout",
+			"The timeout must be a positive Go duration such as 10m, got: "+config.Timeout.ValueString(),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *domainVerificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = providerData
+}
+
+// Create waits for the domain to verify.
+func (r *domainVerificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan domainVerificationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, plan.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	timeout, err := time.ParseDuration(plan.Timeout.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid Timeout", err.Error())
+		return
+	}
+
+	domain, err := r.waitForVerification(ctx, session, plan.DomainID.ValueString(), plan.VerifySending.ValueBool(), timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Domain Not Verified", err.Error())
+		return
+	}
+
+	setDomainVerificationModel(&plan, domain)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the verification timestamps. A domain that lost its
+// verification is not re-awaited; replace the resource to wait again.
+func (r *domainVerificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state domainVerificationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	domain, err := utils.GetDomain(ctx, session, state.DomainID.ValueString())
+	if utils.IsNotFound(err) {
+		tflog.Warn(ctx, "Domain no longer exists, removing verification from state", map[string]interface{}{
+			"id": state.DomainID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Domain", err.Error())
+		return
+	}
+
+	setDomainVerificationModel(&state, domain)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only stores the new timeout and credentials; there is nothing to wait for.
+func (r *domainVerificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan domainVerificationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from state without changing the domain.
+func (r *domainVerificationResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// waitForVerification polls the domain with exponential backoff until it is
+// verified or timeout expires, in which case the error names the checks that
+// are still pending. The sending record check is only triggered when sending
+// is set, as the API cannot trigger the check for receiving. Failed requests
+// are retried until the timeout, and only the last error is reported.
+func (r *domainVerificationResource) waitForVerification(ctx context.Context, session *utils.Session, id string, sending bool, timeout time.Duration) (*utils.Domain, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	interval := domainCheckMinInterval
+	pending := "no check completed"
+	sendingResult := ""
+	for attempt := 1; ; attempt++ {
+		var lastErr error
+		if sending {
+			check, err := utils.CheckDomainSending(ctx, session, id)
+			switch {
+			case err != nil && ctx.Err() == nil:
+				lastErr = err
+			case check != nil:
+				sendingResult = check.Message
+			}
+		}
+
+		domain, err := utils.GetDomain(ctx, session, id)
+		switch {
+		case utils.IsNotFound(err):
+			return nil, err
+		case err != nil:
+			if ctx.Err() == nil {
+				lastErr = err
+			}
+		case domainVerified(domain, sending):
+			return domain, nil
+		default:
+			pending = pendingDomainChecks(domain, sending, sendingResult)
+		}
+
+		tflog.Debug(ctx, "Domain not verified yet", map[string]interface{}{
+			"id":      id,
+			"attempt": attempt,
+			"pending": pending,
+		})
+
+		select {
+		case <-ctx.Done():
+			if lastErr != nil {
+				return nil, fmt.Errorf("domain %s was not verified within %s, %s; last error: %w", id, timeout, pending, lastErr)
+			}
+			return nil, fmt.Errorf("domain %s was not verified within %s, %s", id, timeout, pending)
+		case <-time.After(interval):
+		}
+		interval = min(interval*2, domainCheckMaxInterval)
+	}
+}
+
+// pendingDomainChecks describes the checks domain has not passed yet.
+// sendingResult is the message of the last sending record check, if any.
+func pendingDomainChecks(domain *utils.Domain, sending bool, sendingResult string) string {
+	var pending []string
+	if domain.DomainVerifiedAt == nil {
+		pending = append(pending, "domain ownership verification")
+	}
+	if domain.DomainMxValidatedAt == nil {
+		pending = append(pending, "MX record validation, which Addy re-checks once a day")
+	}
+	if sending && domain.DomainSendingVerifiedAt == nil {
+		check := "sending record verification"
+		if sendingResult != "" {
+			check += " (" + sendingResult + ")"
+		}
+		pending = append(pending, check)
+	}
+	return "still waiting for " + strings.Join(pending, " and ")
+}
+
+// domainVerified reports whether domain is verified for receiving and, if
+// sending is set, for sending.
+func domainVerified(domain *utils.Domain, sending bool) bool {
+	if domain.DomainVerifiedAt == nil || domain.DomainMxValidatedAt == nil {
+		return false
+	}
+	return !sending || domain.DomainSendingVerifiedAt != nil
+}
+
+func setDomainVerificationModel(model *domainVerificationModel, domain *utils.Domain) {
+	model.ID = types.StringValue(domain.ID)
+	model.DomainVerifiedAt = types.StringPointerValue(domain.DomainVerifiedAt)
+	model.DomainMxValidatedAt = types.StringPointerValue(domain.DomainMxValidatedAt)
+	model.DomainSendingVerifiedAt = types.StringPointerValue(domain.DomainSendingVerifiedAt)
+}
@@ -0,0 +1,425 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &recipientResource{}
+	_ resource.ResourceWithConfigure      = &recipientResource{}
+	_ resource.ResourceWithValidateConfig = &recipientResource{}
+	_ resource.ResourceWithModifyPlan     = &recipientResource{}
+	_ resource.ResourceWithImportState    = &recipientResource{}
+	_ resource.ResourceWithIdentity       = &recipientResource{}
+)
+
+// NewRecipientResource is a helper function to simplify the provider implementation.
+func NewRecipientResource() resource.Resource {
+	return &recipientResource{}
+}
+
+// recipientResource is the resource implementation.
+type recipientResource struct {
+	providerData *ResourceData
+}
+
+type recipientModel struct {
+	credentialsModel
+	ID                  types.String `tfsdk:"id"`
+	Email               types.String `tfsdk:"email"`
+	CanReplySend        types.Bool   `tfsdk:"can_reply_send"`
+	WaitForVerification types.Bool   `tfsdk:"wait_for_verification"`
+	Timeout             types.String `tfsdk:"timeout"`
+	Verified            types.Bool   `tfsdk:"verified"`
+	EmailVerifiedAt     types.String `tfsdk:"email_verified_at"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+}
+
+// recipientIdentityModel identifies a recipient by its ID and its email.
+type recipientIdentityModel struct {
+	ID    types.String `tfsdk:"id"`
+	Email types.String `tfsdk:"email"`
+}
+
+// Metadata returns the resource type name.
+func (r *recipientResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_recipient"
+}
+
+// Schema defines the schema for the resource.
+func (r *recipientResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a recipient. Addy emails new recipients a verification link; aliases do not forward " +
+			"to a recipient until it is verified.",
+
+		Attributes: withCredentials(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the recipient.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the recipient. Changing this creates a new recipient.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"can_reply_send": schema.BoolAttribute{
+				MarkdownDescription: "Whether the recipient may reply to and send from aliases.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"wait_for_verification": schema.BoolAttribute{
+				MarkdownDescription: "Wait on create until the recipient has verified its email address, so aliases " +
+					"created afterwards forward straight away. If the wait times out the recipient is kept and a warning " +
+					"is shown. Only used when the recipient is created; changing it later has no effect. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for verification, as a Go duration such as `30m`. Only used when the " +
+					"recipient is created; changing it later has no effect. Defaults to `10m`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("10m"),
+			},
+			"verified": schema.BoolAttribute{
+				MarkdownDescription: "Whether the recipient has verified its email address.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email_verified_at": schema.StringAttribute{
+				MarkdownDescription: "When the recipient verified its email address. Null if not verified.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The creation timestamp of the recipient.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		}),
+	}
+}
+
+// IdentitySchema defines the identity of the resource. Either attribute is
+// enough to import a recipient.
+func (r *recipientResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the recipient.",
+				OptionalForImport: true,
+			},
+			"email": identityschema.StringAttribute{
+				Description:       "The email address of the recipient.",
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *recipientResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = providerData
+}
+
+// ValidateConfig checks that timeout is a valid duration.
+func (r *recipientResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config recipientModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Timeout.IsNull() || config.Timeout.IsUnknown() {
+		return
+	}
+	if timeout, err := time.ParseDuration(config.Timeout.ValueString()); err != nil || timeout <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeout"),
+			"Invalid Timeout",
+			"The timeout must be a positive Go duration such as 10m, got: "+config.Timeout.ValueString(),
+		)
+	}
+}
+
+// ModifyPlan checks planned recipient creations against the account's limits.
+func (r *recipientResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.providerData == nil {
+		return
+	}
+
+	var plan recipientModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.planSession(ctx, plan.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	reserveCreation(ctx, req, resp, session, utils.QuotaRecipients, "email", "Recipient")
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *recipientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan recipientModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, plan.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Creating recipient", map[string]interface{}{
+		"email": plan.Email.ValueString(),
+	})
+
+	recipient, err := utils.CreateRecipient(ctx, session, plan.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Recipient", err.Error())
+		return
+	}
+
+	// Save the ID and credentials straight away so a failure below
+	// leaves the recipient tracked (and tainted) instead of orphaned.
+	resp.Diagnostics.Append(setCreatedID(ctx, &resp.State, recipient.ID, plan.credentialsModel)...)
+
+	if err := r.apply(ctx, session, recipient, plan); err != nil {
+		resp.Diagnostics.AddError("Unable to Configure Recipient", err.Error())
+		return
+	}
+
+	if plan.WaitForVerification.ValueBool() {
+		timeout, err := time.ParseDuration(plan.Timeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid Timeout", err.Error())
+			return
+		}
+
+		tflog.Debug(ctx, "Waiting for recipient verification", map[string]interface{}{
+			"id":      recipient.ID,
+			"timeout": timeout.String(),
+		})
+
+		// The recipient is created and configured at this point, so a wait
+		// that runs out is only a warning. An error would taint it, and the
+		// next apply would replace it and send yet another verification email.
+		waitCtx, cancel := context.WithTimeout(ctx, timeout)
+		verified, err := utils.WaitForRecipientVerification(waitCtx, session, recipient.ID)
+		cancel()
+		if err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("wait_for_verification"),
+				"Recipient Not Verified Yet",
+				fmt.Sprintf("The recipient was created but not verified after waiting up to %s: %s. "+
+					"Aliases forward to it once the link in the verification email is followed.", timeout, err.Error()),
+			)
+		} else {
+			verified.CanReplySend = recipient.CanReplySend
+			recipient = verified
+		}
+	}
+
+	plan.set(recipient)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newRecipientIdentity(recipient))...)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *recipientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state recipientModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	recipient, err := utils.GetRecipient(ctx, session, state.ID.ValueString())
+	if utils.IsNotFound(err) {
+		tflog.Warn(ctx, "Recipient no longer exists, removing from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Recipient", err.Error())
+		return
+	}
+
+	state.set(recipient)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newRecipientIdentity(recipient))...)
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// wait_for_verification and timeout only apply on create and are just stored.
+func (r *recipientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state recipientModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, plan.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	recipient, err := utils.GetRecipient(ctx, session, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Recipient", err.Error())
+		return
+	}
+
+	if err := r.apply(ctx, session, recipient, plan); err != nil {
+		resp.Diagnostics.AddError("Unable to Update Recipient", err.Error())
+		return
+	}
+
+	plan.set(recipient)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newRecipientIdentity(recipient))...)
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *recipientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state recipientModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	err = utils.DeleteRecipient(ctx, session, state.ID.ValueString())
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Delete Recipient", err.Error())
+	}
+}
+
+// apply brings the server-side recipient in line with plan, updating
+// recipient in place.
+func (r *recipientResource) apply(ctx context.Context, session *utils.Session, recipient *utils.Recipient, plan recipientModel) error {
+	if !plan.CanReplySend.IsUnknown() && plan.CanReplySend.ValueBool() != recipient.CanReplySend {
+		if err := utils.SetRecipientCanReplySend(ctx, session, recipient.ID, plan.CanReplySend.ValueBool()); err != nil {
+			return err
+		}
+		recipient.CanReplySend = plan.CanReplySend.ValueBool()
+	}
+	return nil
+}
+
+func (model *recipientModel) set(recipient *utils.Recipient) {
+	model.ID = types.StringValue(recipient.ID)
+	// Keep the configured casing of the email.
+	if !strings.EqualFold(model.Email.ValueString(), recipient.Email) {
+		model.Email = types.StringValue(recipient.Email)
+	}
+	model.CanReplySend = types.BoolValue(recipient.CanReplySend)
+	// Imported and listed recipients get the defaults of the create-only settings.
+	if model.WaitForVerification.IsNull() {
+		model.WaitForVerification = types.BoolValue(false)
+	}
+	if model.Timeout.IsNull() {
+		model.Timeout = types.StringValue("10m")
+	}
+	model.Verified = types.BoolValue(recipient.Verified())
+	model.EmailVerifiedAt = types.StringPointerValue(recipient.EmailVerifiedAt)
+	model.CreatedAt = types.StringValue(recipient.CreatedAt)
+}
+
+// ImportState imports a recipient by ID or email, given either as the import
+// ID or through the identity attributes. Emails are looked up with the
+// provider's credentials, as import gets no resource configuration.
+func (r *recipientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.providerData.importByKey(ctx, req, resp, importKey{
+		noun:      "Recipient",
+		attribute: "email",
+		name:      "email",
+		find: func(ctx context.Context, session *utils.Session, key string) (string, error) {
+			recipient, err := utils.FindRecipient(ctx, session, key)
+			if err != nil {
+				return "", err
+			}
+			return recipient.ID, nil
+		},
+	})
+}
+
+func newRecipientIdentity(recipient *utils.Recipient) recipientIdentityModel {
+	return recipientIdentityModel{
+		ID:    types.StringValue(recipient.ID),
+		Email: types.StringValue(recipient.Email),
+	}
+}
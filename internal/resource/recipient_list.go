@@ -0,0 +1,125 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &recipientListResource{}
+	_ list.ListResourceWithConfigure = &recipientListResource{}
+)
+
+// NewRecipientListResource is a helper function to simplify the provider implementation.
+func NewRecipientListResource() list.ListResource {
+	return &recipientListResource{}
+}
+
+// recipientListResource lists existing recipients for terraform query.
+type recipientListResource struct {
+	providerData *ResourceData
+}
+
+type recipientListModel struct {
+	credentialsModel
+	Verified types.Bool `tfsdk:"verified"`
+}
+
+// Metadata returns the managed resource type name being listed.
+func (r *recipientListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_recipient"
+}
+
+// ListResourceConfigSchema defines the filters accepted by list blocks.
+func (r *recipientListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the recipients in the account.",
+
+		Attributes: withListCredentials(map[string]schema.Attribute{
+			"verified": schema.BoolAttribute{
+				MarkdownDescription: "Only list recipients that have (`true`) or have not (`false`) verified their email address.",
+				Optional:            true,
+			},
+		}),
+	}
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *recipientListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *ResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = providerData
+}
+
+// List streams the recipients matching the configured filters.
+func (r *recipientListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config recipientListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Listing recipients")
+
+	session, err := r.providerData.session(ctx, config.credentialsModel)
+	if err != nil {
+		diags.AddError("Unable to Create Addy API Session", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	recipients, err := utils.ListRecipients(ctx, session)
+	if err != nil {
+		diags.AddError("Unable to List Recipients", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, recipient := range recipients {
+			if !config.Verified.IsNull() && config.Verified.ValueBool() != recipient.Verified() {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = recipient.Email
+			result.Diagnostics.Append(result.Identity.Set(ctx, newRecipientIdentity(&recipient))...)
+
+			if req.IncludeResource {
+				var model recipientModel
+				model.set(&recipient)
+				result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
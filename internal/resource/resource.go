@@ -0,0 +1,310 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ResourceData struct {
+	Sessions      *utils.Sessions
+	ManagedMarker string
+	Defaults      AliasDefaults
+}
+
+// AliasDefaults holds the provider-level values applied during plan to
+// aliases that omit the corresponding attributes.
+type AliasDefaults struct {
+	AliasDomain  string
+	AliasFormat  string
+	RecipientIds []string
+	FromName     string
+}
+
+// credentialsModel maps the optional per-resource credential overrides.
+type credentialsModel struct {
+	ApiKey  types.String `tfsdk:"api_key"`
+	BaseURL types.String `tfsdk:"base_url"`
+}
+
+// unknown reports whether an override is only known at apply, e.g. computed
+// from another resource. The session cannot be resolved until then.
+func (c credentialsModel) unknown() bool {
+	return c.ApiKey.IsUnknown() || c.BaseURL.IsUnknown()
+}
+
+// session returns the session for the resource's credential overrides,
+// falling back to the provider configuration.
+func (p *ResourceData) session(ctx context.Context, creds credentialsModel) (*utils.Session, error) {
+	if creds.unknown() {
+		return nil, fmt.Errorf("the api_key and base_url overrides must be known to reach the Addy API")
+	}
+	return p.Sessions.Resolve(ctx, creds.ApiKey.ValueString(), creds.BaseURL.ValueString())
+}
+
+// planSession is session for ModifyPlan. It returns nil when the overrides
+// are unknown, in which case plan-time lookups and checks are skipped.
+func (p *ResourceData) planSession(ctx context.Context, creds credentialsModel) (*utils.Session, error) {
+	if creds.unknown() {
+		return nil, nil
+	}
+	return p.session(ctx, creds)
+}
+
+// setCreatedID saves the ID of a just created object along with the
+// credential overrides, so that a failure later in Create leaves the object
+// tracked (and tainted) instead of orphaned, and so that it is read and
+// destroyed with the credentials it was created with.
+func setCreatedID(ctx context.Context, state *tfsdk.State, id string, creds credentialsModel) diag.Diagnostics {
+	diags := state.SetAttribute(ctx, path.Root("id"), id)
+	diags.Append(state.SetAttribute(ctx, path.Root("api_key"), creds.ApiKey)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("base_url"), creds.BaseURL)...)
+	return diags
+}
+
+// reserveCreation reserves a planned creation of kind against the account's
+// limits, reporting an exceeded limit on attribute. noun names the resource
+// in diagnostics, e.g. "Domain". Updates reserve nothing, and a nil session,
+// i.e. unknown credentials, leaves the check to apply.
+func reserveCreation(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, session *utils.Session, kind utils.QuotaKind, attribute string, noun string) {
+	if !req.State.Raw.IsNull() || session == nil {
+		return
+	}
+	if err := session.Quota.Reserve(ctx, kind); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(attribute), "Addy "+noun+" Limit Reached", err.Error())
+	}
+}
+
+// importKey describes how a resource is imported by its natural key.
+type importKey struct {
+	// noun names the resource in diagnostics, e.g. "Domain".
+	noun string
+	// attribute is the identity attribute holding the natural key, e.g.
+	// "domain", and name describes it, e.g. "domain name".
+	attribute string
+	name      string
+	// find returns the ID of the object with the natural key.
+	find func(ctx context.Context, session *utils.Session, key string) (string, error)
+}
+
+// importByKey imports a resource by ID or natural key, given either as the
+// import ID or through the identity attributes. Natural keys are looked up
+// with the provider's credentials, as import gets no resource configuration.
+func (p *ResourceData) importByKey(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, key importKey) {
+	value := req.ID
+
+	if value == "" && req.Identity != nil {
+		var id, natural types.String
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(key.attribute), &natural)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		value = id.ValueString()
+		if value == "" {
+			value = natural.ValueString()
+		}
+	}
+
+	if value == "" {
+		resp.Diagnostics.AddError(
+			"Missing "+key.noun+" Import Identifier",
+			fmt.Sprintf("Import by ID or %s, either as the import ID or through the id or %s identity attribute.", key.name, key.attribute),
+		)
+		return
+	}
+
+	id := value
+	if !utils.IsUUID(value) {
+		found, err := key.find(ctx, p.Sessions.Default, value)
+		if err != nil {
+			resp.Diagnostics.AddError(key.noun+" Not Found", err.Error())
+			return
+		}
+		id = found
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// withCredentials adds the api_key and base_url override attributes to a
+// resource schema.
+func withCredentials(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["api_key"] = schema.StringAttribute{
+		MarkdownDescription: "API key used instead of the provider's for this resource. " +
+			"Imports always use the provider's credentials, as no configuration is available during import.",
+		Optional:  true,
+		Sensitive: true,
+	}
+	attributes["base_url"] = schema.StringAttribute{
+		MarkdownDescription: "Base URL of the Addy instance used instead of the provider's for this resource.",
+		Optional:            true,
+	}
+	return attributes
+}
+
+// withListCredentials adds the api_key and base_url override attributes to a
+// list resource schema.
+func withListCredentials(attributes map[string]listschema.Attribute) map[string]listschema.Attribute {
+	attributes["api_key"] = listschema.StringAttribute{
+		MarkdownDescription: "API key used instead of the provider's for this list.",
+		Optional:            true,
+	}
+	attributes["base_url"] = listschema.StringAttribute{
+		MarkdownDescription: "Base URL of the Addy instance used instead of the provider's for this list.",
+		Optional:            true,
+	}
+	return attributes
+}
+
+// optionalString returns nil for an empty string so it is sent as null.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// stringValueOrNull maps nil and empty API strings to a null value.
+func stringValueOrNull(s *string) types.String {
+	if s == nil || *s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*s)
+}
+
+// managed reports whether description carries the managed marker.
+func managed(description *string, marker string) bool {
+	return description != nil && utils.HasMarker(*description, marker)
+}
+
+// equalStrings compares optional API strings, treating nil and empty as equal.
+func equalStrings(a *string, b *string) bool {
+	var av, bv string
+	if a != nil {
+		av = *a
+	}
+	if b != nil {
+		bv = *b
+	}
+	return av == bv
+}
+
+// recipientSets returns the IDs and emails of recipients as string sets.
+func recipientSets(ctx context.Context, recipients []utils.Recipient) (types.Set, types.Set, diag.Diagnostics) {
+	ids := make([]string, 0, len(recipients))
+	emails := make([]string, 0, len(recipients))
+	for _, recipient := range recipients {
+		ids = append(ids, recipient.ID)
+		emails = append(emails, recipient.Email)
+	}
+
+	idSet, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	emailSet, d := types.SetValueFrom(ctx, types.StringType, emails)
+	diags.Append(d...)
+	return idSet, emailSet, diags
+}
+
+// resolveRecipientSets resolves whichever of the configured recipient ID and
+// email sets is known into the other during plan. The set that is unknown,
+// e.g. computed from another resource, is returned unknown along with its
+// counterpart. A nil session, i.e. unknown credentials, leaves the
+// counterpart unknown, and so do emails of recipients that do not exist or
+// are unverified yet, as an addy_recipient in the same configuration may
+// create them first. recipientIDs resolves those during apply.
+func resolveRecipientSets(ctx context.Context, session *utils.Session, ids types.Set, emails types.Set) (types.Set, types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	unknown := types.SetUnknown(types.StringType)
+
+	switch {
+	case ids.IsUnknown() || emails.IsUnknown():
+		return unknown, unknown, diags
+	case session == nil && !emails.IsNull():
+		return unknown, emails, diags
+	case session == nil && !ids.IsNull():
+		return ids, unknown, diags
+	case !emails.IsNull():
+		var values []string
+		diags.Append(emails.ElementsAs(ctx, &values, false)...)
+		if diags.HasError() {
+			return unknown, unknown, diags
+		}
+		recipients, err := utils.RecipientsByEmail(ctx, session, values)
+		if utils.IsUnresolvedRecipient(err) {
+			return unknown, emails, diags
+		}
+		if err != nil {
+			diags.AddAttributeError(path.Root("recipient_emails"), "Unable to Resolve Recipient Emails", err.Error())
+			return unknown, unknown, diags
+		}
+		resolved, _, d := recipientSets(ctx, recipients)
+		diags.Append(d...)
+		return resolved, emails, diags
+	case !ids.IsNull():
+		var values []string
+		diags.Append(ids.ElementsAs(ctx, &values, false)...)
+		if diags.HasError() {
+			return unknown, unknown, diags
+		}
+		recipients, err := utils.RecipientsByID(ctx, session, values)
+		if err != nil {
+			diags.AddAttributeError(path.Root("recipient_ids"), "Unable to Resolve Recipient IDs", err.Error())
+			return unknown, unknown, diags
+		}
+		_, resolved, d := recipientSets(ctx, recipients)
+		diags.Append(d...)
+		return ids, resolved, diags
+	default:
+		return types.SetNull(types.StringType), types.SetNull(types.StringType), diags
+	}
+}
+
+// recipientIDs resolves recipient emails to IDs during apply, where they are
+// left unknown when plan could not resolve them. Unlike at plan, recipients
+// that do not exist or are unverified are an error.
+func recipientIDs(ctx context.Context, session *utils.Session, emails []string) ([]string, error) {
+	recipients, err := utils.RecipientsByEmail(ctx, session, emails)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(recipients))
+	for _, recipient := range recipients {
+		ids = append(ids, recipient.ID)
+	}
+	return ids, nil
+}
+
+// keepEmailCase returns prior if it holds the same emails as current apart
+// from case, so the server's casing does not show as drift.
+func keepEmailCase(ctx context.Context, prior types.Set, current types.Set) types.Set {
+	if prior.IsNull() || prior.IsUnknown() {
+		return current
+	}
+
+	var priorEmails, currentEmails []string
+	if prior.ElementsAs(ctx, &priorEmails, false).HasError() || current.ElementsAs(ctx, &currentEmails, false).HasError() {
+		return current
+	}
+	if len(priorEmails) != len(currentEmails) {
+		return current
+	}
+	for _, email := range priorEmails {
+		if !slices.ContainsFunc(currentEmails, func(e string) bool { return strings.EqualFold(e, email) }) {
+			return current
+		}
+	}
+	return prior
+}
@@ -0,0 +1,495 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &ruleResource{}
+	_ resource.ResourceWithConfigure      = &ruleResource{}
+	_ resource.ResourceWithValidateConfig = &ruleResource{}
+	_ resource.ResourceWithImportState    = &ruleResource{}
+	_ resource.ResourceWithIdentity       = &ruleResource{}
+)
+
+// NewRuleResource is a helper function to simplify the provider implementation.
+func NewRuleResource() resource.Resource {
+	return &ruleResource{}
+}
+
+// ruleResource is the resource implementation.
+type ruleResource struct {
+	providerData *ResourceData
+}
+
+type ruleModel struct {
+	credentialsModel
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Operator   types.String `tfsdk:"operator"`
+	Conditions types.List   `tfsdk:"conditions"`
+	Actions    types.List   `tfsdk:"actions"`
+	Forwards   types.Bool   `tfsdk:"forwards"`
+	Replies    types.Bool   `tfsdk:"replies"`
+	Sends      types.Bool   `tfsdk:"sends"`
+	Active     types.Bool   `tfsdk:"active"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+}
+
+// ruleIdentityModel identifies a rule by its ID and its name.
+type ruleIdentityModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+type ruleConditionModel struct {
+	Type   types.String `tfsdk:"type"`
+	Match  types.String `tfsdk:"match"`
+	Values types.List   `tfsdk:"values"`
+}
+
+type ruleActionModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+var ruleConditionType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"type":   types.StringType,
+	"match":  types.StringType,
+	"values": types.ListType{ElemType: types.StringType},
+}}
+
+var ruleActionType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"type":  types.StringType,
+	"value": types.StringType,
+}}
+
+// Metadata returns the resource type name.
+func (r *ruleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rule"
+	// The name is part of the identity and can be changed in place.
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// Schema defines the schema for the resource.
+func (r *ruleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a rule that changes emails matching its conditions.",
+
+		Attributes: withCredentials(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the rule.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the rule.",
+				Required:            true,
+			},
+			"operator": schema.StringAttribute{
+				MarkdownDescription: "Whether all (`AND`) or any (`OR`) of the conditions must match. Defaults to `AND`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("AND"),
+			},
+			"conditions": schema.ListNestedAttribute{
+				MarkdownDescription: "The conditions the email is matched against.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The part of the email to match, e.g. `sender`, `subject` or `alias`.",
+							Required:            true,
+						},
+						"match": schema.StringAttribute{
+							MarkdownDescription: "How the values are matched, e.g. `is exactly`, `contains` or `starts with`.",
+							Required:            true,
+						},
+						"values": schema.ListAttribute{
+							MarkdownDescription: "The values to match.",
+							ElementType:         types.StringType,
+							Required:            true,
+						},
+					},
+				},
+			},
+			"actions": schema.ListNestedAttribute{
+				MarkdownDescription: "The actions applied to matching emails.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The action, e.g. `subject`, `displayFrom`, `encryption`, `banner` or `block`.",
+							Required:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The value of the action, e.g. the new subject. " +
+								"The `encryption`, `block` and `removeAttachments` actions take `true` or `false`.",
+							Required: true,
+						},
+					},
+				},
+			},
+			"forwards": schema.BoolAttribute{
+				MarkdownDescription: "Whether the rule applies to forwarded emails. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"replies": schema.BoolAttribute{
+				MarkdownDescription: "Whether the rule applies to replies. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"sends": schema.BoolAttribute{
+				MarkdownDescription: "Whether the rule applies to emails sent from aliases. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the rule is active.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The creation timestamp of the rule.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		}),
+	}
+}
+
+// IdentitySchema defines the identity of the resource. Either attribute is
+// enough to import a rule, though the name only when no other rule shares it.
+func (r *ruleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the rule.",
+				OptionalForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "The name of the rule.",
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ruleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = providerData
+}
+
+// ValidateConfig checks the operator.
+func (r *ruleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ruleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Operator.IsNull() && !config.Operator.IsUnknown() && !slices.Contains(utils.RuleOperators, config.Operator.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("operator"),
+			"Invalid Rule Operator",
+			"operator must be one of "+strings.Join(utils.RuleOperators, ", ")+", got: "+config.Operator.ValueString(),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ruleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ruleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, plan.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	request, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating rule", map[string]interface{}{
+		"name": request.Name,
+	})
+
+	rule, err := utils.CreateRule(ctx, session, request)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Rule", err.Error())
+		return
+	}
+
+	// Save the ID and credentials straight away so a failure below
+	// leaves the rule tracked (and tainted) instead of orphaned.
+	resp.Diagnostics.Append(setCreatedID(ctx, &resp.State, rule.ID, plan.credentialsModel)...)
+
+	if err := setRuleActive(ctx, session, rule, plan.Active); err != nil {
+		resp.Diagnostics.AddError("Unable to Configure Rule", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.set(ctx, rule)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newRuleIdentity(rule))...)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ruleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ruleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	rule, err := utils.GetRule(ctx, session, state.ID.ValueString())
+	if utils.IsNotFound(err) {
+		tflog.Warn(ctx, "Rule no longer exists, removing from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Rule", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.set(ctx, rule)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newRuleIdentity(rule))...)
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ruleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ruleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, plan.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	request, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := utils.UpdateRule(ctx, session, state.ID.ValueString(), request)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Update Rule", err.Error())
+		return
+	}
+
+	if err := setRuleActive(ctx, session, rule, plan.Active); err != nil {
+		resp.Diagnostics.AddError("Unable to Update Rule", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.set(ctx, rule)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newRuleIdentity(rule))...)
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ruleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ruleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	err = utils.DeleteRule(ctx, session, state.ID.ValueString())
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Delete Rule", err.Error())
+	}
+}
+
+// ImportState imports a rule by ID or name, given either as the import ID or
+// through the identity attributes. Rules are looked up with the provider's
+// credentials, as import gets no resource configuration.
+func (r *ruleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.providerData.importByKey(ctx, req, resp, importKey{
+		noun:      "Rule",
+		attribute: "name",
+		name:      "rule name",
+		find: func(ctx context.Context, session *utils.Session, key string) (string, error) {
+			rule, err := utils.FindRule(ctx, session, key)
+			if err != nil {
+				return "", err
+			}
+			return rule.ID, nil
+		},
+	})
+}
+
+func newRuleIdentity(rule *utils.Rule) ruleIdentityModel {
+	return ruleIdentityModel{
+		ID:   types.StringValue(rule.ID),
+		Name: types.StringValue(rule.Name),
+	}
+}
+
+// setRuleActive toggles rule when the planned active differs from the
+// server's, updating rule on success.
+func setRuleActive(ctx context.Context, session *utils.Session, rule *utils.Rule, active types.Bool) error {
+	if active.IsUnknown() || active.ValueBool() == rule.Active {
+		return nil
+	}
+	if err := utils.SetRuleActive(ctx, session, rule.ID, active.ValueBool()); err != nil {
+		return err
+	}
+	rule.Active = active.ValueBool()
+	return nil
+}
+
+// request converts the planned rule into the create and update request body.
+func (model *ruleModel) request(ctx context.Context) (utils.RuleRequest, diag.Diagnostics) {
+	request := utils.RuleRequest{
+		Name:     model.Name.ValueString(),
+		Operator: model.Operator.ValueString(),
+		Forwards: model.Forwards.ValueBool(),
+		Replies:  model.Replies.ValueBool(),
+		Sends:    model.Sends.ValueBool(),
+	}
+
+	var conditions []ruleConditionModel
+	var actions []ruleActionModel
+	diags := model.Conditions.ElementsAs(ctx, &conditions, false)
+	diags.Append(model.Actions.ElementsAs(ctx, &actions, false)...)
+	if diags.HasError() {
+		return request, diags
+	}
+
+	for _, condition := range conditions {
+		var values []string
+		diags.Append(condition.Values.ElementsAs(ctx, &values, false)...)
+		request.Conditions = append(request.Conditions, utils.RuleCondition{
+			Type:   condition.Type.ValueString(),
+			Match:  condition.Match.ValueString(),
+			Values: values,
+		})
+	}
+	for _, action := range actions {
+		request.Actions = append(request.Actions, utils.RuleAction{
+			Type:  action.Type.ValueString(),
+			Value: action.Value.ValueString(),
+		})
+	}
+	return request, diags
+}
+
+// set copies the server-side rule into model.
+func (model *ruleModel) set(ctx context.Context, rule *utils.Rule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conditions := make([]ruleConditionModel, 0, len(rule.Conditions))
+	for _, condition := range rule.Conditions {
+		values, d := types.ListValueFrom(ctx, types.StringType, condition.Values)
+		diags.Append(d...)
+		conditions = append(conditions, ruleConditionModel{
+			Type:   types.StringValue(condition.Type),
+			Match:  types.StringValue(condition.Match),
+			Values: values,
+		})
+	}
+
+	actions := make([]ruleActionModel, 0, len(rule.Actions))
+	for _, action := range rule.Actions {
+		actions = append(actions, ruleActionModel{
+			Type:  types.StringValue(action.Type),
+			Value: types.StringValue(action.Value),
+		})
+	}
+
+	var d diag.Diagnostics
+	model.Conditions, d = types.ListValueFrom(ctx, ruleConditionType, conditions)
+	diags.Append(d...)
+	model.Actions, d = types.ListValueFrom(ctx, ruleActionType, actions)
+	diags.Append(d...)
+
+	model.ID = types.StringValue(rule.ID)
+	model.Name = types.StringValue(rule.Name)
+	model.Operator = types.StringValue(rule.Operator)
+	model.Forwards = types.BoolValue(rule.Forwards)
+	model.Replies = types.BoolValue(rule.Replies)
+	model.Sends = types.BoolValue(rule.Sends)
+	model.Active = types.BoolValue(rule.Active)
+	model.CreatedAt = types.StringValue(rule.CreatedAt)
+	return diags
+}
@@ -0,0 +1,125 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &ruleListResource{}
+	_ list.ListResourceWithConfigure = &ruleListResource{}
+)
+
+// NewRuleListResource is a helper function to simplify the provider implementation.
+func NewRuleListResource() list.ListResource {
+	return &ruleListResource{}
+}
+
+// ruleListResource lists existing rules for terraform query.
+type ruleListResource struct {
+	providerData *ResourceData
+}
+
+type ruleListModel struct {
+	credentialsModel
+	Active types.Bool `tfsdk:"active"`
+}
+
+// Metadata returns the managed resource type name being listed.
+func (r *ruleListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rule"
+}
+
+// ListResourceConfigSchema defines the filters accepted by list blocks.
+func (r *ruleListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the rules in the account.",
+
+		Attributes: withListCredentials(map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Only list rules that are active (`true`) or inactive (`false`).",
+				Optional:            true,
+			},
+		}),
+	}
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *ruleListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *ResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = providerData
+}
+
+// List streams the rules matching the configured filters.
+func (r *ruleListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config ruleListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Listing rules")
+
+	session, err := r.providerData.session(ctx, config.credentialsModel)
+	if err != nil {
+		diags.AddError("Unable to Create Addy API Session", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	rules, err := utils.ListRules(ctx, session)
+	if err != nil {
+		diags.AddError("Unable to List Rules", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, rule := range rules {
+			if !config.Active.IsNull() && config.Active.ValueBool() != rule.Active {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = rule.Name
+			result.Diagnostics.Append(result.Identity.Set(ctx, newRuleIdentity(&rule))...)
+
+			if req.IncludeResource {
+				var model ruleModel
+				result.Diagnostics.Append(model.set(ctx, &rule)...)
+				result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
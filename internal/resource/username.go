@@ -0,0 +1,407 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aRustyDev/terraform-provider-addy/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &usernameResource{}
+	_ resource.ResourceWithConfigure      = &usernameResource{}
+	_ resource.ResourceWithValidateConfig = &usernameResource{}
+	_ resource.ResourceWithModifyPlan     = &usernameResource{}
+	_ resource.ResourceWithImportState    = &usernameResource{}
+	_ resource.ResourceWithIdentity       = &usernameResource{}
+)
+
+// NewUsernameResource is a helper function to simplify the provider implementation.
+func NewUsernameResource() resource.Resource {
+	return &usernameResource{}
+}
+
+// usernameResource is the resource implementation.
+type usernameResource struct {
+	providerData *ResourceData
+}
+
+type usernameModel struct {
+	credentialsModel
+	aliasHostModel
+	ID        types.String `tfsdk:"id"`
+	Username  types.String `tfsdk:"username"`
+	CanLogin  types.Bool   `tfsdk:"can_login"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// usernameIdentityModel identifies a username by its ID and its name.
+type usernameIdentityModel struct {
+	ID       types.String `tfsdk:"id"`
+	Username types.String `tfsdk:"username"`
+}
+
+// Metadata returns the resource type name.
+func (r *usernameResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_username"
+}
+
+// Schema defines the schema for the resource.
+func (r *usernameResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an additional username.",
+
+		Attributes: withCredentials(withAliasHostAttributes("username", map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the username.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username. Changing this creates a new username.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"can_login": schema.BoolAttribute{
+				MarkdownDescription: "Whether the username can be used to log in.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The creation timestamp of the username.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		})),
+	}
+}
+
+// IdentitySchema defines the identity of the resource. Either attribute is
+// enough to import a username.
+func (r *usernameResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the username.",
+				OptionalForImport: true,
+			},
+			"username": identityschema.StringAttribute{
+				Description:       "The username.",
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *usernameResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = providerData
+}
+
+// ValidateConfig checks that at most one of default_recipient_id and
+// default_recipient_email is set.
+func (r *usernameResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config usernameModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(config.validate()...)
+}
+
+// ModifyPlan checks planned username creations against the account's limits
+// and attributes against the server version, and resolves the default
+// recipient so both its ID and email are planned.
+func (r *usernameResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.providerData == nil {
+		return
+	}
+
+	var config, plan usernameModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.planSession(ctx, plan.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	reserveCreation(ctx, req, resp, session, utils.QuotaUsernames, "username", "Username")
+	config.aliasHostModel.plan(ctx, session, resp)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *usernameResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan usernameModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, plan.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Creating username", map[string]interface{}{
+		"username": plan.Username.ValueString(),
+	})
+
+	username, err := utils.CreateUsername(ctx, session, plan.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Username", err.Error())
+		return
+	}
+
+	// Save the ID and credentials straight away so a failure below
+	// leaves the username tracked (and tainted) instead of orphaned.
+	resp.Diagnostics.Append(setCreatedID(ctx, &resp.State, username.ID, plan.credentialsModel)...)
+
+	username, err = r.apply(ctx, session, username, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Configure Username", err.Error())
+		return
+	}
+
+	r.setModel(&plan, username)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newUsernameIdentity(username))...)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *usernameResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state usernameModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	username, err := utils.GetUsername(ctx, session, state.ID.ValueString())
+	if utils.IsNotFound(err) {
+		tflog.Warn(ctx, "Username no longer exists, removing from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Username", err.Error())
+		return
+	}
+
+	r.setModel(&state, username)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newUsernameIdentity(username))...)
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *usernameResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state usernameModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, plan.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	username, err := utils.GetUsername(ctx, session, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Username", err.Error())
+		return
+	}
+
+	username, err = r.apply(ctx, session, username, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Update Username", err.Error())
+		return
+	}
+
+	r.setModel(&plan, username)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, newUsernameIdentity(username))...)
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *usernameResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state usernameModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := r.providerData.session(ctx, state.credentialsModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Addy API Session", err.Error())
+		return
+	}
+
+	err = utils.DeleteUsername(ctx, session, state.ID.ValueString())
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Delete Username", err.Error())
+	}
+}
+
+// apply brings the server-side username in line with plan, only calling the
+// endpoints whose values differ, and returns the resulting username.
+func (r *usernameResource) apply(ctx context.Context, session *utils.Session, username *utils.Username, plan usernameModel) (*utils.Username, error) {
+	host := &usernameHost{username: username}
+	if err := plan.aliasHostModel.apply(ctx, session, host, r.providerData.ManagedMarker); err != nil {
+		return nil, err
+	}
+	username = host.username
+
+	if !plan.CanLogin.IsUnknown() && plan.CanLogin.ValueBool() != username.CanLogin {
+		if err := utils.SetUsernameCanLogin(ctx, session, username.ID, plan.CanLogin.ValueBool()); err != nil {
+			return nil, err
+		}
+		username.CanLogin = plan.CanLogin.ValueBool()
+	}
+
+	return username, nil
+}
+
+// setModel copies the server-side username into model, stripping the managed
+// marker from the description.
+func (r *usernameResource) setModel(model *usernameModel, username *utils.Username) {
+	model.set(username, r.providerData.ManagedMarker)
+}
+
+func (model *usernameModel) set(username *utils.Username, marker string) {
+	model.ID = types.StringValue(username.ID)
+	// Keep the configured casing of the username.
+	if !strings.EqualFold(model.Username.ValueString(), username.Username) {
+		model.Username = types.StringValue(username.Username)
+	}
+	model.aliasHostModel.set((&usernameHost{username: username}).settings(), marker)
+	model.CanLogin = types.BoolValue(username.CanLogin)
+	model.CreatedAt = types.StringValue(username.CreatedAt)
+}
+
+// ImportState imports a username by ID or username, given either as the
+// import ID or through the identity attributes. Usernames are looked up with
+// the provider's credentials, as import gets no resource configuration.
+func (r *usernameResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.providerData.importByKey(ctx, req, resp, importKey{
+		noun:      "Username",
+		attribute: "username",
+		name:      "username",
+		find: func(ctx context.Context, session *utils.Session, key string) (string, error) {
+			username, err := utils.FindUsername(ctx, session, key)
+			if err != nil {
+				return "", err
+			}
+			return username.ID, nil
+		},
+	})
+}
+
+func newUsernameIdentity(username *utils.Username) usernameIdentityModel {
+	return usernameIdentityModel{
+		ID:       types.StringValue(username.ID),
+		Username: types.StringValue(username.Username),
+	}
+}
+
+// usernameHost applies aliasHostModel to a username.
+type usernameHost struct {
+	username *utils.Username
+}
+
+func (h *usernameHost) settings() aliasHostSettings {
+	return aliasHostSettings{
+		Description:      h.username.Description,
+		FromName:         h.username.FromName,
+		AutoCreateRegex:  h.username.AutoCreateRegex,
+		DefaultRecipient: h.username.DefaultRecipient,
+		Active:           h.username.Active,
+		CatchAll:         h.username.CatchAll,
+	}
+}
+
+func (h *usernameHost) update(ctx context.Context, session *utils.Session, update utils.DomainUpdate) error {
+	username, err := utils.UpdateUsername(ctx, session, h.username.ID, utils.UsernameUpdate(update))
+	if err == nil {
+		h.username = username
+	}
+	return err
+}
+
+func (h *usernameHost) setDefaultRecipient(ctx context.Context, session *utils.Session, recipientID *string) error {
+	username, err := utils.SetUsernameDefaultRecipient(ctx, session, h.username.ID, recipientID)
+	if err == nil {
+		h.username = username
+	}
+	return err
+}
+
+func (h *usernameHost) setActive(ctx context.Context, session *utils.Session, active bool) error {
+	err := utils.SetUsernameActive(ctx, session, h.username.ID, active)
+	if err == nil {
+		h.username.Active = active
+	}
+	return err
+}
+
+func (h *usernameHost) setCatchAll(ctx context.Context, session *utils.Session, catchAll bool) error {
+	err := utils.SetUsernameCatchAll(ctx, session, h.username.ID, catchAll)
+	if err == nil {
+		h.username.CatchAll = catchAll
+	}
+	return err
+}
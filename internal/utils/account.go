@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// AccountDetails is the account summary returned by the account-details endpoint.
+// Limits are nil when the account's plan does not restrict the count.
+type AccountDetails struct {
+	ID                           string  `json:"id"`
+	Username                     string  `json:"username"`
+	FromName                     *string `json:"from_name"`
+	DefaultRecipientID           string  `json:"default_recipient_id"`
+	DefaultAliasDomain           string  `json:"default_alias_domain"`
+	DefaultAliasFormat           string  `json:"default_alias_format"`
+	Subscription                 *string `json:"subscription"`
+	SubscriptionEndsAt           *string `json:"subscription_ends_at"`
+	Bandwidth                    int64   `json:"bandwidth"`
+	BandwidthLimit               *int64  `json:"bandwidth_limit"`
+	UsernameCount                int64   `json:"username_count"`
+	UsernameLimit                *int64  `json:"username_limit"`
+	RecipientCount               int64   `json:"recipient_count"`
+	RecipientLimit               *int64  `json:"recipient_limit"`
+	ActiveDomainCount            int64   `json:"active_domain_count"`
+	ActiveDomainLimit            *int64  `json:"active_domain_limit"`
+	ActiveSharedDomainAliasCount int64   `json:"active_shared_domain_alias_count"`
+	ActiveSharedDomainAliasLimit *int64  `json:"active_shared_domain_alias_limit"`
+	ActiveRuleCount              int64   `json:"active_rule_count"`
+	ActiveRuleLimit              *int64  `json:"active_rule_limit"`
+	TotalAliases                 int64   `json:"total_aliases"`
+	TotalActiveAliases           int64   `json:"total_active_aliases"`
+	TotalInactiveAliases         int64   `json:"total_inactive_aliases"`
+	TotalDeletedAliases          int64   `json:"total_deleted_aliases"`
+	CreatedAt                    string  `json:"created_at"`
+	UpdatedAt                    string  `json:"updated_at"`
+}
+
+// GetAccountDetails fetches the account summary for the session's API key.
+func GetAccountDetails(ctx context.Context, session *Session) (*AccountDetails, error) {
+	body, err := session.Curl(ctx, "account-details", "GET")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Data AccountDetails `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse account details: %w", err)
+	}
+
+	return &resp.Data, nil
+}
@@ -0,0 +1,337 @@
+package utils
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	neturl "net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Alias is an alias as returned by the aliases endpoints.
+type Alias struct {
+	ID          string      `json:"id"`
+	LocalPart   string      `json:"local_part"`
+	Extension   *string     `json:"extension"`
+	Domain      string      `json:"domain"`
+	Email       string      `json:"email"`
+	Active      bool        `json:"active"`
+	Description *string     `json:"description"`
+	FromName    *string     `json:"from_name"`
+	Recipients  []Recipient `json:"recipients"`
+	CreatedAt   string      `json:"created_at"`
+	UpdatedAt   string      `json:"updated_at"`
+	DeletedAt   *string     `json:"deleted_at"`
+}
+
+// AliasCreate holds the fields accepted when creating an alias. Empty fields
+// are left to the account defaults.
+type AliasCreate struct {
+	Domain       string   `json:"domain,omitempty"`
+	Format       string   `json:"format,omitempty"`
+	LocalPart    string   `json:"local_part,omitempty"`
+	Description  *string  `json:"description,omitempty"`
+	RecipientIds []string `json:"recipient_ids,omitempty"`
+}
+
+// AliasUpdate holds the patchable alias fields. Nil pointers clear the value
+// on the server.
+type AliasUpdate struct {
+	Description *string `json:"description"`
+	FromName    *string `json:"from_name"`
+}
+
+func CreateAlias(ctx context.Context, session *Session, create AliasCreate) (*Alias, error) {
+	body, err := session.CurlJSON(ctx, "aliases", "POST", create)
+	if err != nil {
+		return nil, err
+	}
+	return parseAlias(body)
+}
+
+// aliasCreateAttempts is how many times CreateAliasIdempotent sends the
+// create request after ambiguous failures.
+const aliasCreateAttempts = 3
+
+// aliasRecoveryTimeout bounds the requests CreateAliasIdempotent sends to
+// recover the alias after the caller's context is done.
+const aliasRecoveryTimeout = time.Minute
+
+// CreateAliasIdempotent creates an alias, tagging the request with a nonce in
+// the description. When a request fails ambiguously, e.g. it timed out after
+// the server created the alias, the alias carrying the nonce is searched for
+// and adopted instead of creating a duplicate. The search still runs when ctx
+// is done, e.g. cancelled mid-request, so the alias is not left orphaned. The
+// nonce is removed from the description once the alias exists; if that fails,
+// the alias is returned along with the error so the caller can still track it.
+func CreateAliasIdempotent(ctx context.Context, session *Session, create AliasCreate) (*Alias, error) {
+	nonce, err := newNonce()
+	if err != nil {
+		return nil, err
+	}
+
+	original := create.Description
+	description := ""
+	if original != nil {
+		description = *original
+	}
+	tagged := strings.TrimPrefix(description+" "+nonce, " ")
+	create.Description = &tagged
+
+	var alias *Alias
+	for attempt := 1; alias == nil; attempt++ {
+		alias, err = CreateAlias(ctx, session, create)
+		if err == nil || !IsAmbiguous(err) {
+			break
+		}
+
+		tflog.Warn(ctx, "Alias creation failed ambiguously, searching for the alias by nonce", map[string]interface{}{
+			"attempt": attempt,
+			"error":   err.Error(),
+		})
+
+		searchCtx, cancel := recoveryContext(ctx)
+		found, searchErr := findAliasByNonce(searchCtx, session, nonce)
+		cancel()
+		if searchErr != nil {
+			return nil, fmt.Errorf("%w; searching for the alias failed: %v", err, searchErr)
+		}
+		alias = found
+
+		// A done context cannot send another create request.
+		if alias == nil && (attempt >= aliasCreateAttempts || ctx.Err() != nil) {
+			break
+		}
+	}
+	if alias == nil {
+		return nil, err
+	}
+
+	updateCtx, cancel := recoveryContext(ctx)
+	defer cancel()
+	updated, err := UpdateAlias(updateCtx, session, alias.ID, AliasUpdate{
+		Description: original,
+		FromName:    alias.FromName,
+	})
+	if err != nil {
+		return alias, fmt.Errorf("failed to remove the nonce from alias %s: %w", alias.ID, err)
+	}
+	return updated, nil
+}
+
+// findAliasByNonce returns the alias whose description carries nonce, or nil
+// if there is none.
+func findAliasByNonce(ctx context.Context, session *Session, nonce string) (*Alias, error) {
+	aliases, err := ListAliases(ctx, session, AliasFilter{Search: nonce})
+	if err != nil {
+		return nil, err
+	}
+	for _, alias := range aliases {
+		if alias.Description != nil && strings.Contains(*alias.Description, nonce) {
+			return &alias, nil
+		}
+	}
+	return nil, nil
+}
+
+// recoveryContext returns ctx while it is live, or else a fresh context with
+// its values and aliasRecoveryTimeout, so cleanup can still reach the API.
+func recoveryContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if ctx.Err() == nil {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(context.WithoutCancel(ctx), aliasRecoveryTimeout)
+}
+
+// newNonce returns a random marker identifying one create request.
+func newNonce() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return "[tf-nonce:" + hex.EncodeToString(b) + "]", nil
+}
+
+// FindAlias looks up an alias by ID, or by email, compared
+// case-insensitively, through the list endpoint.
+func FindAlias(ctx context.Context, session *Session, key string) (*Alias, error) {
+	if IsUUID(key) {
+		return GetAlias(ctx, session, key)
+	}
+
+	aliases, err := ListAliases(ctx, session, AliasFilter{Search: key})
+	if err != nil {
+		return nil, err
+	}
+	for _, alias := range aliases {
+		if strings.EqualFold(alias.Email, key) {
+			return &alias, nil
+		}
+	}
+	return nil, fmt.Errorf("no alias with ID or email %q exists in the account", key)
+}
+
+func GetAlias(ctx context.Context, session *Session, id string) (*Alias, error) {
+	body, err := session.Curl(ctx, "aliases/"+id, "GET")
+	if err != nil {
+		return nil, err
+	}
+	return parseAlias(body)
+}
+
+func UpdateAlias(ctx context.Context, session *Session, id string, update AliasUpdate) (*Alias, error) {
+	body, err := session.CurlJSON(ctx, "aliases/"+id, "PATCH", update)
+	if err != nil {
+		return nil, err
+	}
+	return parseAlias(body)
+}
+
+// DeleteAlias soft deletes an alias; it can still be restored.
+func DeleteAlias(ctx context.Context, session *Session, id string) error {
+	_, err := session.Curl(ctx, "aliases/"+id, "DELETE")
+	return err
+}
+
+// ForgetAlias permanently deletes an alias and disassociates it from the account.
+func ForgetAlias(ctx context.Context, session *Session, id string) error {
+	_, err := session.Curl(ctx, "aliases/"+id+"/forget", "DELETE")
+	return err
+}
+
+// SetAliasRecipients replaces the full set of recipients attached to an
+// alias. An empty set makes the alias use the account's default recipient.
+func SetAliasRecipients(ctx context.Context, session *Session, id string, recipientIds []string) (*Alias, error) {
+	if recipientIds == nil {
+		recipientIds = []string{}
+	}
+	body, err := session.CurlJSON(ctx, "alias-recipients", "POST", map[string]any{
+		"alias_id":      id,
+		"recipient_ids": recipientIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseAlias(body)
+}
+
+// AliasFilter holds the server-side filters of the aliases list endpoint.
+// Empty fields are not sent.
+type AliasFilter struct {
+	Search string
+	Active *bool
+	// Deleted is "with" to include soft-deleted aliases or "only" to list
+	// nothing else.
+	Deleted string
+}
+
+func ListAliases(ctx context.Context, session *Session, filter AliasFilter) ([]Alias, error) {
+	query := neturl.Values{}
+	if filter.Search != "" {
+		query.Set("filter[search]", filter.Search)
+	}
+	if filter.Active != nil {
+		query.Set("filter[active]", strconv.FormatBool(*filter.Active))
+	}
+	if filter.Deleted != "" {
+		query.Set("filter[deleted]", filter.Deleted)
+	}
+
+	endpoint := "aliases"
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	return listAll[Alias](ctx, session, endpoint)
+}
+
+// AliasBulkLimit is the largest number of IDs accepted by one bulk request.
+const AliasBulkLimit = 25
+
+// AliasBulkOperations are the operations with an aliases/{operation}/bulk endpoint.
+var AliasBulkOperations = []string{"activate", "deactivate", "delete", "forget", "restore"}
+
+// BulkAliases applies operation to at most AliasBulkLimit aliases and returns
+// the IDs the server reports as processed.
+func BulkAliases(ctx context.Context, session *Session, operation string, ids []string) ([]string, error) {
+	body, err := session.CurlJSON(ctx, "aliases/"+operation+"/bulk", "POST", map[string][]string{"ids": ids})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		IDs []string `json:"ids"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse bulk %s response: %w", operation, err)
+	}
+	return resp.IDs, nil
+}
+
+// RestoreAlias restores a soft-deleted alias.
+func RestoreAlias(ctx context.Context, session *Session, id string) (*Alias, error) {
+	body, err := session.Curl(ctx, "aliases/"+id+"/restore", "PATCH")
+	if err != nil {
+		return nil, err
+	}
+	return parseAlias(body)
+}
+
+// FindDeletedAlias returns the soft-deleted alias with the given local part
+// on domain, or nil if there is none. A soft-deleted alias still reserves its
+// address, so creating it again fails until it is restored or forgotten.
+func FindDeletedAlias(ctx context.Context, session *Session, localPart string, domain string) (*Alias, error) {
+	aliases, err := ListAliases(ctx, session, AliasFilter{Search: localPart, Deleted: "only"})
+	if err != nil {
+		return nil, err
+	}
+	for _, alias := range aliases {
+		if strings.EqualFold(alias.LocalPart, localPart) && strings.EqualFold(alias.Domain, domain) {
+			return &alias, nil
+		}
+	}
+	return nil, nil
+}
+
+// SetAliasActive toggles the alias through the active-aliases endpoints.
+func SetAliasActive(ctx context.Context, session *Session, id string, active bool) error {
+	return toggle(ctx, session, "active-aliases", id, active)
+}
+
+// AliasFormats lists the alias formats accepted by the Addy API.
+var AliasFormats = []string{"random_characters", "uuid", "random_words", "custom"}
+
+// AliasDeletionModes are the ways RemoveAlias can remove an alias, from least
+// to most destructive.
+var AliasDeletionModes = []string{"deactivate", "delete", "forget"}
+
+// RemoveAlias removes an alias according to mode: "deactivate" keeps it but
+// stops forwarding, "delete" soft deletes it and "forget" deletes it
+// permanently.
+func RemoveAlias(ctx context.Context, session *Session, id string, mode string) error {
+	switch mode {
+	case "deactivate":
+		return SetAliasActive(ctx, session, id, false)
+	case "delete":
+		return DeleteAlias(ctx, session, id)
+	case "forget":
+		return ForgetAlias(ctx, session, id)
+	default:
+		return fmt.Errorf("unknown alias deletion mode %q", mode)
+	}
+}
+
+func parseAlias(body []byte) (*Alias, error) {
+	var resp struct {
+		Data Alias `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse alias: %w", err)
+	}
+	return &resp.Data, nil
+}
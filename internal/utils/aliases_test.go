@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// fakeAliasAPI serves the alias endpoints CreateAliasIdempotent uses. Each
+// create request is answered with the next status in createStatuses; a 201
+// creates the alias, and so does a 500 when createdOn500 is set.
+type fakeAliasAPI struct {
+	createStatuses []int
+	createdOn500   bool
+	// onCreate, if set, runs after each create request is handled but
+	// before it is answered.
+	onCreate func()
+
+	creates  int
+	searches int
+	alias    *Alias
+}
+
+func (f *fakeAliasAPI) handle(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/aliases":
+			var create AliasCreate
+			if err := json.NewDecoder(r.Body).Decode(&create); err != nil {
+				t.Errorf("decoding create request: %v", err)
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			status := f.createStatuses[f.creates]
+			f.creates++
+			if status == http.StatusCreated || (status >= 500 && f.createdOn500) {
+				f.alias = &Alias{ID: "a1", Email: "x@anonaddy.me", Description: create.Description}
+			}
+			if f.onCreate != nil {
+				f.onCreate()
+			}
+			w.WriteHeader(status)
+			if status == http.StatusCreated {
+				json.NewEncoder(w).Encode(map[string]any{"data": f.alias})
+			}
+
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/aliases":
+			f.searches++
+			search := r.URL.Query().Get("filter[search]")
+			aliases := []Alias{}
+			if f.alias != nil && strings.Contains(*f.alias.Description, search) {
+				aliases = append(aliases, *f.alias)
+			}
+			json.NewEncoder(w).Encode(map[string]any{"data": aliases})
+
+		case r.Method == http.MethodPatch && f.alias != nil && r.URL.Path == "/api/v1/aliases/"+f.alias.ID:
+			var update AliasUpdate
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				t.Errorf("decoding update request: %v", err)
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			f.alias.Description = update.Description
+			json.NewEncoder(w).Encode(map[string]any{"data": f.alias})
+
+		default:
+			http.NotFound(w, r)
+		}
+	}
+}
+
+func TestCreateAliasIdempotentAdoptsAliasAfterServerError(t *testing.T) {
+	api := &fakeAliasAPI{createStatuses: []int{http.StatusBadGateway}, createdOn500: true}
+	session := newTestSession(t, api.handle(t))
+
+	description := "newsletter"
+	alias, err := CreateAliasIdempotent(context.Background(), session, AliasCreate{Description: &description})
+	if err != nil {
+		t.Fatalf("CreateAliasIdempotent: %v", err)
+	}
+
+	if alias.ID != "a1" {
+		t.Errorf("alias ID = %q, want a1", alias.ID)
+	}
+	if alias.Description == nil || *alias.Description != description {
+		t.Errorf("alias description = %v, want the nonce removed", alias.Description)
+	}
+	if api.creates != 1 {
+		t.Errorf("create requests = %d, want 1", api.creates)
+	}
+}
+
+func TestCreateAliasIdempotentAdoptsAliasAfterCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	api := &fakeAliasAPI{createStatuses: []int{http.StatusBadGateway}, createdOn500: true, onCreate: cancel}
+	session := newTestSession(t, api.handle(t))
+
+	alias, err := CreateAliasIdempotent(ctx, session, AliasCreate{})
+	if err != nil {
+		t.Fatalf("CreateAliasIdempotent: %v", err)
+	}
+
+	if alias.ID != "a1" {
+		t.Errorf("alias ID = %q, want a1", alias.ID)
+	}
+	if api.creates != 1 || api.searches != 1 {
+		t.Errorf("create requests = %d and searches = %d, want 1 and 1", api.creates, api.searches)
+	}
+}
+
+func TestCreateAliasIdempotentRetriesAfterServerError(t *testing.T) {
+	api := &fakeAliasAPI{createStatuses: []int{http.StatusInternalServerError, http.StatusCreated}}
+	session := newTestSession(t, api.handle(t))
+
+	alias, err := CreateAliasIdempotent(context.Background(), session, AliasCreate{})
+	if err != nil {
+		t.Fatalf("CreateAliasIdempotent: %v", err)
+	}
+
+	if alias.ID != "a1" {
+		t.Errorf("alias ID = %q, want a1", alias.ID)
+	}
+	if alias.Description != nil {
+		t.Errorf("alias description = %q, want the nonce removed", *alias.Description)
+	}
+	if api.creates != 2 || api.searches != 1 {
+		t.Errorf("create requests = %d and searches = %d, want 2 and 1", api.creates, api.searches)
+	}
+}
+
+func TestCreateAliasIdempotentFailsOnClientError(t *testing.T) {
+	api := &fakeAliasAPI{createStatuses: []int{http.StatusUnprocessableEntity}}
+	session := newTestSession(t, api.handle(t))
+
+	alias, err := CreateAliasIdempotent(context.Background(), session, AliasCreate{})
+	if err == nil {
+		t.Fatalf("CreateAliasIdempotent succeeded with alias %v, want an error", alias)
+	}
+	if alias != nil {
+		t.Errorf("alias = %v, want nil", alias)
+	}
+	if api.creates != 1 || api.searches != 0 {
+		t.Errorf("create requests = %d and searches = %d, want 1 and 0", api.creates, api.searches)
+	}
+}
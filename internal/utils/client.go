@@ -1,21 +1,29 @@
 package utils
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var url string = "https://app.addy.io"
 var ver string = "v1"
-var api string = url + "/api" + "/" + ver
 
-func NewClient(ctx context.Context) (*http.Client, error) {
+// requestTimeout bounds each request, so a stalled connection fails instead
+// of hanging the run.
+const requestTimeout = 30 * time.Second
+
+func NewClient(ctx context.Context, url string) (*http.Client, error) {
 	tflog.Info(ctx, "Creating http.Client")
 	client := &http.Client{
+		Timeout: requestTimeout,
 		// CheckRedirect: redirectPolicyFunc,
 	}
 	tflog.Trace(ctx, "http.Client Created: ") // TODO: pretty print out client object
@@ -47,15 +55,63 @@ func NewClient(ctx context.Context) (*http.Client, error) {
 	}
 }
 
-func Curl(ctx context.Context, client *http.Client, endpoint string, method string, token string) ([]byte, error) {
-	var bearer string = "Bearer " + token
+// APIError is returned by Curl when the API responds with a non-2xx status.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
 
-	tflog.Debug(ctx, "Response status")
-	req, err := http.NewRequest(method, api+"/"+endpoint, nil)
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// IsNotFound reports whether err is an API 404, e.g. for an object deleted outside Terraform.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsAmbiguous reports whether err leaves it unknown if the request took
+// effect: the response was lost or the server failed with a 5xx status.
+func IsAmbiguous(err error) bool {
+	if err == nil {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+	return true
+}
+
+func (s *Session) Curl(ctx context.Context, endpoint string, method string) ([]byte, error) {
+	return s.CurlJSON(ctx, endpoint, method, nil)
+}
+
+// CurlJSON is Curl with payload encoded as the JSON request body. A nil
+// payload sends no body.
+func (s *Session) CurlJSON(ctx context.Context, endpoint string, method string, payload any) ([]byte, error) {
+	var bearer string = "Bearer " + s.ApiKey
+	var api string = s.BaseURL + "/api" + "/" + ver
+
+	var reqBody io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode request body: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, api+"/"+endpoint, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
+	if err := s.Limiter.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("failed to wait for the rate limiter: %w", err)
+	}
+
 	req.Header.Add("Authorization", bearer)
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("X-Requested-With", "XMLHttpRequest")
@@ -66,7 +122,7 @@ func Curl(ctx context.Context, client *http.Client, endpoint string, method stri
 		"url":      api + "/" + endpoint,
 	})
 
-	resp, err := client.Do(req)
+	resp, err := s.Client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
@@ -83,7 +139,7 @@ func Curl(ctx context.Context, client *http.Client, endpoint string, method stri
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
 	}
 
 	return body, nil
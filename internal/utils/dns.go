@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DNSRecord is a DNS record required for a custom domain. Name is relative
+// to the domain, with "@" for the apex.
+type DNSRecord struct {
+	Type     string
+	Name     string
+	Value    string
+	Priority int64
+	TTL      int64
+}
+
+// DNSTargets are the Addy mail server names a custom domain's records point
+// at. Self-hosted instances use their own configured host names.
+type DNSTargets struct {
+	// MXHosts are the mail servers, in order of preference.
+	MXHosts []string
+	// SPFInclude is the domain included by the SPF record.
+	SPFInclude string
+	// DKIMDomain is the domain the dk1 and dk2 DKIM selectors are delegated to.
+	DKIMDomain string
+}
+
+// HostedDNSTargets are the targets documented for the hosted Addy service.
+var HostedDNSTargets = DNSTargets{
+	MXHosts:    []string{"mail.anonaddy.me", "mail2.anonaddy.me"},
+	SPFInclude: "spf.anonaddy.me",
+	DKIMDomain: "anonaddy.me",
+}
+
+// dkimSelectors are the DKIM selectors Addy signs with.
+var dkimSelectors = []string{"dk1", "dk2"}
+
+// DomainDNSRecords returns the records a custom domain needs to receive and
+// send mail through the Addy servers in targets. The verification TXT record
+// is only included when token is set, as the API does not expose it.
+func DomainDNSRecords(targets DNSTargets, token string, ttl int64) []DNSRecord {
+	var records []DNSRecord
+	for i, host := range targets.MXHosts {
+		records = append(records, DNSRecord{Type: "MX", Name: "@", Value: fqdn(host), Priority: int64(10 * (i + 1)), TTL: ttl})
+	}
+	records = append(records, DNSRecord{Type: "TXT", Name: "@", Value: "v=spf1 include:" + strings.TrimSuffix(targets.SPFInclude, ".") + " -all", TTL: ttl})
+	for _, selector := range dkimSelectors {
+		name := selector + "._domainkey"
+		records = append(records, DNSRecord{Type: "CNAME", Name: name, Value: fqdn(name + "." + targets.DKIMDomain), TTL: ttl})
+	}
+	records = append(records, DNSRecord{Type: "TXT", Name: "_dmarc", Value: "v=DMARC1; p=quarantine; adkim=s", TTL: ttl})
+	if token != "" {
+		records = append(records, DNSRecord{Type: "TXT", Name: "@", Value: "aa-verify=" + token, TTL: ttl})
+	}
+	return records
+}
+
+// fqdn returns host fully qualified with a trailing dot.
+func fqdn(host string) string {
+	return strings.TrimSuffix(host, ".") + "."
+}
+
+// RenderZonefile renders records as a BIND zone file fragment for domain.
+func RenderZonefile(domain string, records []DNSRecord) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s.\n", strings.TrimSuffix(domain, "."))
+	for _, record := range records {
+		value := record.Value
+		if record.Type == "TXT" {
+			value = strconv.Quote(value)
+		}
+		if record.Type == "MX" {
+			value = strconv.FormatInt(record.Priority, 10) + " " + value
+		}
+		fmt.Fprintf(&b, "%s\t%d\tIN\t%s\t%s\n", record.Name, record.TTL, record.Type, value)
+	}
+	return b.String()
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDomainDNSRecordsUsesTargets(t *testing.T) {
+	targets := DNSTargets{
+		MXHosts:    []string{"mx.example.net.", "mx2.example.net"},
+		SPFInclude: "spf.example.net",
+		DKIMDomain: "example.net",
+	}
+	zone := RenderZonefile("example.com", DomainDNSRecords(targets, "token", 300))
+
+	for _, want := range []string{
+		"@\t300\tIN\tMX\t10 mx.example.net.\n",
+		"@\t300\tIN\tMX\t20 mx2.example.net.\n",
+		"@\t300\tIN\tTXT\t\"v=spf1 include:spf.example.net -all\"\n",
+		"dk1._domainkey\t300\tIN\tCNAME\tdk1._domainkey.example.net.\n",
+		"dk2._domainkey\t300\tIN\tCNAME\tdk2._domainkey.example.net.\n",
+		"@\t300\tIN\tTXT\t\"aa-verify=token\"\n",
+	} {
+		if !strings.Contains(zone, want) {
+			t.Errorf("zone file is missing %q:\n%s", want, zone)
+		}
+	}
+}
+
+func TestHostedDNSTargets(t *testing.T) {
+	zone := RenderZonefile("example.com", DomainDNSRecords(HostedDNSTargets, "", 3600))
+
+	for _, want := range []string{
+		"MX\t10 mail.anonaddy.me.\n",
+		"MX\t20 mail2.anonaddy.me.\n",
+		"include:spf.anonaddy.me -all",
+		"CNAME\tdk1._domainkey.anonaddy.me.\n",
+	} {
+		if !strings.Contains(zone, want) {
+			t.Errorf("zone file is missing %q:\n%s", want, zone)
+		}
+	}
+	if strings.Contains(zone, "aa-verify") {
+		t.Errorf("zone file has a verification record without a token:\n%s", zone)
+	}
+}
@@ -0,0 +1,171 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Domain is a custom domain as returned by the domains endpoints.
+type Domain struct {
+	ID                      string     `json:"id"`
+	Domain                  string     `json:"domain"`
+	Description             *string    `json:"description"`
+	FromName                *string    `json:"from_name"`
+	AutoCreateRegex         *string    `json:"auto_create_regex"`
+	AliasesCount            int64      `json:"aliases_count"`
+	DefaultRecipient        *Recipient `json:"default_recipient"`
+	Active                  bool       `json:"active"`
+	CatchAll                bool       `json:"catch_all"`
+	DomainVerifiedAt        *string    `json:"domain_verified_at"`
+	DomainMxValidatedAt     *string    `json:"domain_mx_validated_at"`
+	DomainSendingVerifiedAt *string    `json:"domain_sending_verified_at"`
+	CreatedAt               string     `json:"created_at"`
+	UpdatedAt               string     `json:"updated_at"`
+}
+
+// DomainUpdate holds the patchable domain fields. Nil pointers clear the
+// value on the server.
+type DomainUpdate struct {
+	Description     *string `json:"description"`
+	FromName        *string `json:"from_name"`
+	AutoCreateRegex *string `json:"auto_create_regex"`
+}
+
+func ListDomains(ctx context.Context, session *Session) ([]Domain, error) {
+	body, err := session.Curl(ctx, "domains", "GET")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Data []Domain `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse domains: %w", err)
+	}
+
+	return resp.Data, nil
+}
+
+// FindDomain looks up a domain by ID, or by name through the list endpoint.
+func FindDomain(ctx context.Context, session *Session, key string) (*Domain, error) {
+	if IsUUID(key) {
+		return GetDomain(ctx, session, key)
+	}
+
+	domains, err := ListDomains(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	for _, domain := range domains {
+		if strings.EqualFold(domain.Domain, key) {
+			return &domain, nil
+		}
+	}
+	return nil, fmt.Errorf("no domain with ID or name %q exists in the account", key)
+}
+
+func GetDomain(ctx context.Context, session *Session, id string) (*Domain, error) {
+	body, err := session.Curl(ctx, "domains/"+id, "GET")
+	if err != nil {
+		return nil, err
+	}
+	return parseDomain(body)
+}
+
+func CreateDomain(ctx context.Context, session *Session, domain string) (*Domain, error) {
+	body, err := session.CurlJSON(ctx, "domains", "POST", map[string]string{"domain": domain})
+	if err != nil {
+		return nil, err
+	}
+	return parseDomain(body)
+}
+
+func UpdateDomain(ctx context.Context, session *Session, id string, update DomainUpdate) (*Domain, error) {
+	body, err := session.CurlJSON(ctx, "domains/"+id, "PATCH", update)
+	if err != nil {
+		return nil, err
+	}
+	return parseDomain(body)
+}
+
+func DeleteDomain(ctx context.Context, session *Session, id string) error {
+	_, err := session.Curl(ctx, "domains/"+id, "DELETE")
+	return err
+}
+
+// SetDomainDefaultRecipient sets the recipient that receives the domain's
+// mail by default. A nil recipientID reverts to the account default.
+func SetDomainDefaultRecipient(ctx context.Context, session *Session, id string, recipientID *string) (*Domain, error) {
+	body, err := session.CurlJSON(ctx, "domains/"+id+"/default-recipient", "PATCH", map[string]*string{"default_recipient": recipientID})
+	if err != nil {
+		return nil, err
+	}
+	return parseDomain(body)
+}
+
+// DefaultRecipientID returns the ID of the domain's default recipient, or
+// nil if it uses the account default.
+func (d *Domain) DefaultRecipientID() *string {
+	if d.DefaultRecipient == nil {
+		return nil
+	}
+	return &d.DefaultRecipient.ID
+}
+
+// SetDomainActive toggles the domain through the active-domains endpoints.
+func SetDomainActive(ctx context.Context, session *Session, id string, active bool) error {
+	return toggle(ctx, session, "active-domains", id, active)
+}
+
+// SetDomainCatchAll toggles the domain through the catch-all-domains endpoints.
+func SetDomainCatchAll(ctx context.Context, session *Session, id string, catchAll bool) error {
+	return toggle(ctx, session, "catch-all-domains", id, catchAll)
+}
+
+func parseDomain(body []byte) (*Domain, error) {
+	var resp struct {
+		Data Domain `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse domain: %w", err)
+	}
+	return &resp.Data, nil
+}
+
+// toggle enables an object by POSTing its ID to a toggle endpoint such as
+// active-domains, and disables it by DELETEing the ID from that endpoint.
+func toggle(ctx context.Context, session *Session, endpoint string, id string, enabled bool) error {
+	var err error
+	if enabled {
+		_, err = session.CurlJSON(ctx, endpoint, "POST", map[string]string{"id": id})
+	} else {
+		_, err = session.Curl(ctx, endpoint+"/"+id, "DELETE")
+	}
+	return err
+}
+
+// DomainCheck is the outcome of a domain sending record check.
+type DomainCheck struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+// CheckDomainSending triggers the server-side check of the SPF, DKIM and
+// DMARC records that verify a domain for sending, and returns the reported
+// outcome. The API has no endpoint to check the verification and MX records
+// for receiving; Addy checks those on its own schedule.
+func CheckDomainSending(ctx context.Context, session *Session, id string) (*DomainCheck, error) {
+	body, err := session.Curl(ctx, "domains/"+id+"/check-sending", "GET")
+	if err != nil {
+		return nil, err
+	}
+
+	var check DomainCheck
+	if err := json.Unmarshal(body, &check); err != nil {
+		return nil, fmt.Errorf("failed to parse domain check: %w", err)
+	}
+	return &check, nil
+}
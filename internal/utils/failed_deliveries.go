@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// FailedDelivery is a failed delivery as returned by the failed-deliveries endpoints.
+type FailedDelivery struct {
+	ID             string  `json:"id"`
+	RecipientID    *string `json:"recipient_id"`
+	RecipientEmail *string `json:"recipient_email"`
+	AliasID        *string `json:"alias_id"`
+	AliasEmail     *string `json:"alias_email"`
+	BounceType     *string `json:"bounce_type"`
+	RemoteMta      *string `json:"remote_mta"`
+	Sender         *string `json:"sender"`
+	Destination    *string `json:"destination"`
+	EmailType      *string `json:"email_type"`
+	Status         *string `json:"status"`
+	Code           *string `json:"code"`
+	AttemptedAt    *string `json:"attempted_at"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
+}
+
+func GetFailedDelivery(ctx context.Context, session *Session, id string) (*FailedDelivery, error) {
+	body, err := session.Curl(ctx, "failed-deliveries/"+id, "GET")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Data FailedDelivery `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse failed delivery: %w", err)
+	}
+
+	return &resp.Data, nil
+}
+
+// DownloadFailedDelivery returns the original RFC 822 message of a failed delivery.
+func DownloadFailedDelivery(ctx context.Context, session *Session, id string) ([]byte, error) {
+	return session.Curl(ctx, "failed-deliveries/"+id+"/download", "GET")
+}
+
+func ListFailedDeliveries(ctx context.Context, session *Session) ([]FailedDelivery, error) {
+	return listAll[FailedDelivery](ctx, session, "failed-deliveries")
+}
+
+// FailedDeliveryFilter selects failed deliveries client-side. Empty fields
+// match everything.
+type FailedDeliveryFilter struct {
+	AliasID      string
+	RecipientID  string
+	BounceType   string
+	CreatedAfter *time.Time
+}
+
+// Matches reports whether delivery satisfies every set field of the filter.
+func (f FailedDeliveryFilter) Matches(delivery *FailedDelivery) bool {
+	if f.AliasID != "" && (delivery.AliasID == nil || *delivery.AliasID != f.AliasID) {
+		return false
+	}
+	if f.RecipientID != "" && (delivery.RecipientID == nil || *delivery.RecipientID != f.RecipientID) {
+		return false
+	}
+	if f.BounceType != "" && (delivery.BounceType == nil || *delivery.BounceType != f.BounceType) {
+		return false
+	}
+	if f.CreatedAfter != nil {
+		createdAt, err := ParseTimestamp(delivery.CreatedAt)
+		if err != nil || !createdAt.After(*f.CreatedAfter) {
+			return false
+		}
+	}
+	return true
+}
+
+// ResendFailedDelivery attempts delivery of a failed message again.
+func ResendFailedDelivery(ctx context.Context, session *Session, id string) error {
+	_, err := session.Curl(ctx, "failed-deliveries/"+id+"/resend", "POST")
+	return err
+}
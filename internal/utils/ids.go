@@ -0,0 +1,13 @@
+package utils
+
+import (
+	"regexp"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// IsUUID reports whether s looks like an Addy object ID rather than a natural
+// key such as a domain name or email address.
+func IsUUID(s string) bool {
+	return uuidPattern.MatchString(s)
+}
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// requestsPerMinute is the API rate limit of the hosted Addy instance.
+const requestsPerMinute = 60
+
+// Limiter keeps a session within the API rate limit by allowing at most
+// limit requests in any window, delaying the ones that would exceed it. sent
+// holds the times of recent and reserved requests in order.
+type Limiter struct {
+	limit  int
+	window time.Duration
+
+	mu   sync.Mutex
+	sent []time.Time
+}
+
+func NewLimiter(limit int, window time.Duration) *Limiter {
+	return &Limiter{
+		limit:  limit,
+		window: window,
+	}
+}
+
+// Wait blocks until another request may be sent or ctx is done. The slot is
+// reserved under the lock and awaited without it, so concurrent callers wait
+// side by side and a cancelled caller returns right away.
+func (l *Limiter) Wait(ctx context.Context) error {
+	slot := l.reserve(time.Now())
+
+	wait := time.Until(slot)
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.release(slot)
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve records and returns the earliest time from now at which a request
+// keeps the limiter within limit requests per window.
+func (l *Limiter) reserve(now time.Time) time.Time {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	expired := 0
+	for expired < len(l.sent) && !l.sent[expired].After(now.Add(-l.window)) {
+		expired++
+	}
+	l.sent = l.sent[expired:]
+
+	slot := now
+	if len(l.sent) >= l.limit {
+		slot = l.sent[len(l.sent)-l.limit].Add(l.window)
+	}
+	l.sent = append(l.sent, slot)
+	return slot
+}
+
+// release gives back a slot that was reserved but not used.
+func (l *Limiter) release(slot time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if i := slices.IndexFunc(l.sent, slot.Equal); i >= 0 {
+		l.sent = slices.Delete(l.sent, i, i+1)
+	}
+}
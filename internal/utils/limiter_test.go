@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLimiterDelaysRequestsBeyondTheLimit(t *testing.T) {
+	l := NewLimiter(2, 50*time.Millisecond)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("Wait #%d: %v", i+1, err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("third request sent after %s, want at least one window", elapsed)
+	}
+}
+
+func TestLimiterHonoursContext(t *testing.T) {
+	l := NewLimiter(1, time.Hour)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); err == nil {
+		t.Error("Wait beyond the limit returned before the context was done")
+	}
+}
+
+func TestLimiterReleasesCancelledWaiters(t *testing.T) {
+	l := NewLimiter(1, 100*time.Millisecond)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+
+	// A waiter cancelled long before its slot neither blocks the next
+	// caller nor keeps its slot.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := l.Wait(ctx); err == nil {
+		t.Fatal("Wait beyond the limit returned before the context was done")
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("cancelled Wait returned after %s, want right after the context was done", elapsed)
+	}
+
+	start = time.Now()
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 150*time.Millisecond {
+		t.Errorf("Wait after a cancelled waiter returned after %s, want within one window", elapsed)
+	}
+}
+
+func TestLimiterDoesNotSerializeWaiters(t *testing.T) {
+	l := NewLimiter(1, time.Hour)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+
+	// A waiter parked on a slot an hour away must not stop another caller
+	// from reaching its own deadline.
+	parked, cancelParked := context.WithCancel(context.Background())
+	defer cancelParked()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		l.Wait(parked)
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := l.Wait(ctx); err == nil {
+		t.Error("Wait beyond the limit returned before the context was done")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Wait returned after %s, want right after the context was done", elapsed)
+	}
+
+	cancelParked()
+	<-done
+}
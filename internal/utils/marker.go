@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"strings"
+)
+
+// AppendMarker returns description with the managed marker appended. The
+// marker is appended even when description already ends with it, so that
+// StripMarker always gives the configured value back. An empty marker
+// returns description unchanged.
+func AppendMarker(description string, marker string) string {
+	if marker == "" {
+		return description
+	}
+	if description == "" {
+		return marker
+	}
+	return description + " " + marker
+}
+
+// StripMarker removes exactly one copy of the managed marker appended by
+// AppendMarker so that the description read back from the API matches the
+// configured value.
+func StripMarker(description string, marker string) string {
+	if !HasMarker(description, marker) {
+		return description
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(description, marker), " ")
+}
+
+// HasMarker reports whether description ends with the managed marker.
+func HasMarker(description string, marker string) bool {
+	return marker != "" && strings.HasSuffix(description, marker)
+}
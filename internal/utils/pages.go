@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// listAll fetches every page of a list endpoint. Endpoints that are not
+// paginated are returned after the first request.
+func listAll[T any](ctx context.Context, session *Session, endpoint string) ([]T, error) {
+	var items []T
+
+	separator := "?"
+	if strings.Contains(endpoint, "?") {
+		separator = "&"
+	}
+
+	for page := 1; ; page++ {
+		body, err := session.Curl(ctx, endpoint+separator+"page[number]="+strconv.Itoa(page), "GET")
+		if err != nil {
+			return nil, err
+		}
+
+		var resp struct {
+			Data []T `json:"data"`
+			Meta *struct {
+				LastPage int `json:"last_page"`
+			} `json:"meta"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", endpoint, err)
+		}
+
+		items = append(items, resp.Data...)
+
+		if resp.Meta == nil || page >= resp.Meta.LastPage {
+			return items, nil
+		}
+	}
+}
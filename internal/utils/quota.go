@@ -0,0 +1,157 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// QuotaKind identifies an object type whose count is limited by the account's plan.
+type QuotaKind string
+
+const (
+	QuotaAliases    QuotaKind = "aliases"
+	QuotaRecipients QuotaKind = "recipients"
+	QuotaUsernames  QuotaKind = "usernames"
+	QuotaDomains    QuotaKind = "domains"
+)
+
+// Quota tracks the objects a plan would create against the account's limits.
+// A single Quota is shared by every resource using the same session for the
+// lifetime of a run, so ModifyPlan calls across resources add up.
+type Quota struct {
+	session *Session
+
+	mu      sync.Mutex
+	account *AccountDetails
+	planned map[QuotaKind]int64
+
+	// private holds the custom domains and usernames of the account, whose
+	// aliases do not count towards the shared domain alias limit. It is
+	// only fetched for alias reservations.
+	private *privateDomains
+}
+
+type privateDomains struct {
+	domains   []string
+	usernames []string
+}
+
+func NewQuota(session *Session) *Quota {
+	return &Quota{
+		session: session,
+		planned: map[QuotaKind]int64{},
+	}
+}
+
+// Reserve records one planned create of kind and returns an error naming the
+// exact limit when the account's plan does not allow it. Account details are
+// fetched on first use.
+func (q *Quota) Reserve(ctx context.Context, kind QuotaKind) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if err := q.loadAccount(ctx); err != nil {
+		return err
+	}
+	return q.reserve(kind)
+}
+
+// ReserveAlias reserves an alias on domain, or on the account's default alias
+// domain when domain is empty. Only aliases on shared domains count towards
+// the account's alias limit, so aliases on custom domains and username
+// subdomains are not reserved.
+func (q *Quota) ReserveAlias(ctx context.Context, domain string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if err := q.loadAccount(ctx); err != nil {
+		return err
+	}
+	if domain == "" {
+		domain = q.account.DefaultAliasDomain
+	}
+
+	if q.private == nil {
+		domains, err := ListDomains(ctx, q.session)
+		if err != nil {
+			return fmt.Errorf("failed to list domains for quota check: %w", err)
+		}
+		usernames, err := ListUsernames(ctx, q.session)
+		if err != nil {
+			return fmt.Errorf("failed to list usernames for quota check: %w", err)
+		}
+
+		private := &privateDomains{usernames: []string{q.account.Username}}
+		for _, d := range domains {
+			private.domains = append(private.domains, d.Domain)
+		}
+		for _, u := range usernames {
+			private.usernames = append(private.usernames, u.Username)
+		}
+		q.private = private
+	}
+
+	if !q.private.shared(domain) {
+		return nil
+	}
+	return q.reserve(QuotaAliases)
+}
+
+// shared reports whether domain is a shared domain, i.e. neither a custom
+// domain nor a username subdomain such as <username>.anonaddy.com.
+func (p *privateDomains) shared(domain string) bool {
+	for _, d := range p.domains {
+		if strings.EqualFold(d, domain) {
+			return false
+		}
+	}
+	for _, u := range p.usernames {
+		if u != "" && len(domain) > len(u) && strings.EqualFold(domain[:len(u)+1], u+".") {
+			return false
+		}
+	}
+	return true
+}
+
+func (q *Quota) loadAccount(ctx context.Context) error {
+	if q.account != nil {
+		return nil
+	}
+
+	account, err := GetAccountDetails(ctx, q.session)
+	if err != nil {
+		return fmt.Errorf("failed to read account details for quota check: %w", err)
+	}
+	q.account = account
+	return nil
+}
+
+func (q *Quota) reserve(kind QuotaKind) error {
+	count, limit := q.usage(kind)
+	if limit != nil && count+q.planned[kind]+1 > *limit {
+		return fmt.Errorf(
+			"this plan would create more %s than the account permits: %d existing and %d planned exceeds the limit of %d",
+			kind, count, q.planned[kind]+1, *limit,
+		)
+	}
+
+	q.planned[kind]++
+	return nil
+}
+
+func (q *Quota) usage(kind QuotaKind) (int64, *int64) {
+	switch kind {
+	case QuotaAliases:
+		return q.account.ActiveSharedDomainAliasCount, q.account.ActiveSharedDomainAliasLimit
+	case QuotaRecipients:
+		return q.account.RecipientCount, q.account.RecipientLimit
+	case QuotaUsernames:
+		return q.account.UsernameCount, q.account.UsernameLimit
+	case QuotaDomains:
+		return q.account.ActiveDomainCount, q.account.ActiveDomainLimit
+	default:
+		return 0, nil
+	}
+}
@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newTestSession returns a session against an httptest server that serves
+// the API endpoints through handler.
+func newTestSession(t *testing.T, handler http.HandlerFunc) *Session {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// NewClient probes the base URL when the session is created.
+		if r.URL.Path == "/" {
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	session, err := NewSession(context.Background(), server.URL, "test")
+	if err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	return session
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+func TestQuotaUsage(t *testing.T) {
+	q := NewQuota(nil)
+	q.account = &AccountDetails{
+		ActiveSharedDomainAliasCount: 1,
+		ActiveSharedDomainAliasLimit: int64Ptr(10),
+		RecipientCount:               2,
+		RecipientLimit:               int64Ptr(20),
+		UsernameCount:                3,
+		UsernameLimit:                int64Ptr(30),
+		ActiveDomainCount:            4,
+	}
+
+	tests := []struct {
+		kind  QuotaKind
+		count int64
+		limit *int64
+	}{
+		{QuotaAliases, 1, int64Ptr(10)},
+		{QuotaRecipients, 2, int64Ptr(20)},
+		{QuotaUsernames, 3, int64Ptr(30)},
+		{QuotaDomains, 4, nil},
+		{QuotaKind("rules"), 0, nil},
+	}
+
+	for _, tt := range tests {
+		count, limit := q.usage(tt.kind)
+		if count != tt.count {
+			t.Errorf("usage(%s) count = %d, want %d", tt.kind, count, tt.count)
+		}
+		if (limit == nil) != (tt.limit == nil) || (limit != nil && *limit != *tt.limit) {
+			t.Errorf("usage(%s) limit = %v, want %v", tt.kind, limit, tt.limit)
+		}
+	}
+}
+
+func TestQuotaReserve(t *testing.T) {
+	requests := 0
+	session := newTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"data":{"recipient_count":1,"recipient_limit":3,"username_count":5}}`))
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if err := session.Quota.Reserve(ctx, QuotaRecipients); err != nil {
+			t.Fatalf("Reserve #%d: %v", i+1, err)
+		}
+	}
+
+	err := session.Quota.Reserve(ctx, QuotaRecipients)
+	if err == nil {
+		t.Fatal("Reserve beyond the limit succeeded")
+	}
+	if want := "1 existing and 3 planned exceeds the limit of 3"; !strings.Contains(err.Error(), want) {
+		t.Errorf("Reserve error = %q, want it to contain %q", err, want)
+	}
+
+	// Kinds without a limit are never refused.
+	for i := 0; i < 10; i++ {
+		if err := session.Quota.Reserve(ctx, QuotaUsernames); err != nil {
+			t.Fatalf("Reserve unlimited: %v", err)
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("account details fetched %d times, want 1", requests)
+	}
+}
+
+func TestQuotaReserveAlias(t *testing.T) {
+	session := newTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/account-details":
+			w.Write([]byte(`{"data":{"username":"johndoe","default_alias_domain":"anonaddy.me",` +
+				`"active_shared_domain_alias_count":0,"active_shared_domain_alias_limit":1}}`))
+		case "/api/v1/domains":
+			w.Write([]byte(`{"data":[{"id":"d1","domain":"example.com"}]}`))
+		case "/api/v1/usernames":
+			w.Write([]byte(`{"data":[{"id":"u1","username":"janedoe"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	ctx := context.Background()
+	for _, domain := range []string{"example.com", "Example.com", "johndoe.anonaddy.com", "janedoe.anonaddy.me"} {
+		if err := session.Quota.ReserveAlias(ctx, domain); err != nil {
+			t.Errorf("ReserveAlias(%q) counted towards the shared domain limit: %v", domain, err)
+		}
+	}
+
+	// The empty domain is the account default, a shared domain.
+	if err := session.Quota.ReserveAlias(ctx, ""); err != nil {
+		t.Fatalf("ReserveAlias on the default domain: %v", err)
+	}
+	if err := session.Quota.ReserveAlias(ctx, "anonaddy.me"); err == nil {
+		t.Error("ReserveAlias beyond the shared domain limit succeeded")
+	}
+}
@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Recipient is a recipient as returned by the recipients endpoints.
+type Recipient struct {
+	ID              string  `json:"id"`
+	Email           string  `json:"email"`
+	ShouldEncrypt   bool    `json:"should_encrypt"`
+	Fingerprint     *string `json:"fingerprint"`
+	CanReplySend    bool    `json:"can_reply_send"`
+	EmailVerifiedAt *string `json:"email_verified_at"`
+	AliasesCount    int64   `json:"aliases_count"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
+// Verified reports whether the recipient has confirmed its email address.
+func (r *Recipient) Verified() bool {
+	return r.EmailVerifiedAt != nil && *r.EmailVerifiedAt != ""
+}
+
+func ListRecipients(ctx context.Context, session *Session) ([]Recipient, error) {
+	return listAll[Recipient](ctx, session, "recipients")
+}
+
+func GetRecipient(ctx context.Context, session *Session, id string) (*Recipient, error) {
+	body, err := session.Curl(ctx, "recipients/"+id, "GET")
+	if err != nil {
+		return nil, err
+	}
+	return parseRecipient(body)
+}
+
+// FindRecipient looks up a recipient by ID, or by email, compared
+// case-insensitively, through the list endpoint.
+func FindRecipient(ctx context.Context, session *Session, key string) (*Recipient, error) {
+	if IsUUID(key) {
+		return GetRecipient(ctx, session, key)
+	}
+
+	recipients, err := ListRecipients(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	for _, recipient := range recipients {
+		if strings.EqualFold(recipient.Email, key) {
+			return &recipient, nil
+		}
+	}
+	return nil, fmt.Errorf("no recipient with ID or email %q exists in the account", key)
+}
+
+// CreateRecipient adds a recipient. The server sends it a verification
+// email; aliases do not forward to it until it is verified.
+func CreateRecipient(ctx context.Context, session *Session, email string) (*Recipient, error) {
+	body, err := session.CurlJSON(ctx, "recipients", "POST", map[string]string{"email": email})
+	if err != nil {
+		return nil, err
+	}
+	return parseRecipient(body)
+}
+
+func DeleteRecipient(ctx context.Context, session *Session, id string) error {
+	_, err := session.Curl(ctx, "recipients/"+id, "DELETE")
+	return err
+}
+
+// SetRecipientCanReplySend toggles whether the recipient may reply and send
+// from aliases through the allowed-recipients endpoints.
+func SetRecipientCanReplySend(ctx context.Context, session *Session, id string, canReplySend bool) error {
+	return toggle(ctx, session, "allowed-recipients", id, canReplySend)
+}
+
+func parseRecipient(body []byte) (*Recipient, error) {
+	var resp struct {
+		Data Recipient `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse recipient: %w", err)
+	}
+	return &resp.Data, nil
+}
+
+// RecipientsByEmail resolves recipient emails, compared case-insensitively,
+// against the recipients list. Unknown and unverified recipients are errors,
+// as aliases do not forward to them.
+func RecipientsByEmail(ctx context.Context, session *Session, emails []string) ([]Recipient, error) {
+	recipients, err := ListRecipients(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := make([]Recipient, 0, len(emails))
+	for _, email := range emails {
+		i := slices.IndexFunc(recipients, func(r Recipient) bool { return strings.EqualFold(r.Email, email) })
+		if i < 0 {
+			return nil, &UnresolvedRecipientError{Email: email}
+		}
+		if !recipients[i].Verified() {
+			return nil, &UnresolvedRecipientError{Email: email, Unverified: true}
+		}
+		resolved = append(resolved, recipients[i])
+	}
+	return resolved, nil
+}
+
+// UnresolvedRecipientError is returned by RecipientsByEmail for an email
+// that does not belong to a verified recipient (yet).
+type UnresolvedRecipientError struct {
+	Email      string
+	Unverified bool
+}
+
+func (e *UnresolvedRecipientError) Error() string {
+	if e.Unverified {
+		return fmt.Sprintf("recipient %q has not verified its email address", e.Email)
+	}
+	return fmt.Sprintf("no recipient with email %q exists in the account", e.Email)
+}
+
+// IsUnresolvedRecipient reports whether err is an UnresolvedRecipientError,
+// e.g. for a recipient created or verified later in the same apply.
+func IsUnresolvedRecipient(err error) bool {
+	var unresolved *UnresolvedRecipientError
+	return errors.As(err, &unresolved)
+}
+
+// RecipientsByID resolves recipient IDs against the recipients list.
+func RecipientsByID(ctx context.Context, session *Session, ids []string) ([]Recipient, error) {
+	recipients, err := ListRecipients(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := make([]Recipient, 0, len(ids))
+	for _, id := range ids {
+		i := slices.IndexFunc(recipients, func(r Recipient) bool { return r.ID == id })
+		if i < 0 {
+			return nil, fmt.Errorf("no recipient with ID %q exists in the account", id)
+		}
+		resolved = append(resolved, recipients[i])
+	}
+	return resolved, nil
+}
+
+// ResendRecipientVerification sends the verification email for an
+// unverified recipient again.
+func ResendRecipientVerification(ctx context.Context, session *Session, id string) error {
+	_, err := session.CurlJSON(ctx, "recipients/email/resend", "POST", map[string]string{"recipient_id": id})
+	return err
+}
+
+// recipientPollInterval is the delay between checks while waiting for a
+// recipient to verify.
+const recipientPollInterval = 10 * time.Second
+
+// WaitForRecipientVerification polls a recipient until its email address is
+// verified or ctx is done.
+func WaitForRecipientVerification(ctx context.Context, session *Session, id string) (*Recipient, error) {
+	for {
+		recipient, err := GetRecipient(ctx, session, id)
+		if err != nil {
+			return nil, err
+		}
+		if recipient.Verified() {
+			return recipient, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("recipient %s was not verified: %w", recipient.Email, ctx.Err())
+		case <-time.After(recipientPollInterval):
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestRecipientsByEmail(t *testing.T) {
+	verifiedAt := "2024-01-01 00:00:00"
+	session := newTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"data": []Recipient{
+			{ID: "r1", Email: "Verified@example.com", EmailVerifiedAt: &verifiedAt},
+			{ID: "r2", Email: "pending@example.com"},
+		}})
+	})
+
+	recipients, err := RecipientsByEmail(context.Background(), session, []string{"verified@example.com"})
+	if err != nil {
+		t.Fatalf("RecipientsByEmail: %v", err)
+	}
+	if len(recipients) != 1 || recipients[0].ID != "r1" {
+		t.Errorf("recipients = %v, want r1", recipients)
+	}
+
+	for _, email := range []string{"pending@example.com", "missing@example.com"} {
+		_, err := RecipientsByEmail(context.Background(), session, []string{"verified@example.com", email})
+		if !IsUnresolvedRecipient(err) {
+			t.Errorf("RecipientsByEmail(%q) error = %v, want an unresolved recipient", email, err)
+		}
+	}
+}
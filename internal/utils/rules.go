@@ -0,0 +1,169 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// RuleOperators are the ways a rule combines its conditions.
+var RuleOperators = []string{"AND", "OR"}
+
+// ruleBoolActions are the action types whose value the API expects as a
+// boolean rather than a string.
+var ruleBoolActions = []string{"encryption", "block", "removeAttachments"}
+
+// Rule is a rule as returned by the rules endpoints.
+type Rule struct {
+	ID         string          `json:"id"`
+	Name       string          `json:"name"`
+	Order      int64           `json:"order"`
+	Conditions []RuleCondition `json:"conditions"`
+	Actions    []RuleAction    `json:"actions"`
+	Operator   string          `json:"operator"`
+	Forwards   bool            `json:"forwards"`
+	Replies    bool            `json:"replies"`
+	Sends      bool            `json:"sends"`
+	Active     bool            `json:"active"`
+	CreatedAt  string          `json:"created_at"`
+	UpdatedAt  string          `json:"updated_at"`
+}
+
+// RuleCondition matches a part of an email, e.g. the subject, against values.
+type RuleCondition struct {
+	Type   string   `json:"type"`
+	Match  string   `json:"match"`
+	Values []string `json:"values"`
+}
+
+// RuleAction changes an email matched by a rule. Value is kept as a string;
+// boolean values such as that of the block action are converted to and from
+// JSON booleans.
+type RuleAction struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+func (a RuleAction) MarshalJSON() ([]byte, error) {
+	var value any = a.Value
+	if slices.Contains(ruleBoolActions, a.Type) {
+		if b, err := strconv.ParseBool(a.Value); err == nil {
+			value = b
+		}
+	}
+	return json.Marshal(struct {
+		Type  string `json:"type"`
+		Value any    `json:"value"`
+	}{a.Type, value})
+}
+
+func (a *RuleAction) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Type  string `json:"type"`
+		Value any    `json:"value"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	a.Type = raw.Type
+	switch v := raw.Value.(type) {
+	case nil:
+		a.Value = ""
+	case string:
+		a.Value = v
+	default:
+		a.Value = fmt.Sprint(v)
+	}
+	return nil
+}
+
+// RuleRequest holds the fields of a rule that are set on create and update.
+type RuleRequest struct {
+	Name       string          `json:"name"`
+	Conditions []RuleCondition `json:"conditions"`
+	Actions    []RuleAction    `json:"actions"`
+	Operator   string          `json:"operator"`
+	Forwards   bool            `json:"forwards"`
+	Replies    bool            `json:"replies"`
+	Sends      bool            `json:"sends"`
+}
+
+func ListRules(ctx context.Context, session *Session) ([]Rule, error) {
+	return listAll[Rule](ctx, session, "rules")
+}
+
+// FindRule looks up a rule by ID, or by name through the list endpoint.
+// Rule names are not unique, so a name shared by several rules is an error.
+func FindRule(ctx context.Context, session *Session, key string) (*Rule, error) {
+	if IsUUID(key) {
+		return GetRule(ctx, session, key)
+	}
+
+	rules, err := ListRules(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []Rule
+	for _, rule := range rules {
+		if strings.EqualFold(rule.Name, key) {
+			found = append(found, rule)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("no rule with ID or name %q exists in the account", key)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("%d rules are named %q, use the ID instead", len(found), key)
+	}
+}
+
+func GetRule(ctx context.Context, session *Session, id string) (*Rule, error) {
+	body, err := session.Curl(ctx, "rules/"+id, "GET")
+	if err != nil {
+		return nil, err
+	}
+	return parseRule(body)
+}
+
+func CreateRule(ctx context.Context, session *Session, rule RuleRequest) (*Rule, error) {
+	body, err := session.CurlJSON(ctx, "rules", "POST", rule)
+	if err != nil {
+		return nil, err
+	}
+	return parseRule(body)
+}
+
+func UpdateRule(ctx context.Context, session *Session, id string, rule RuleRequest) (*Rule, error) {
+	body, err := session.CurlJSON(ctx, "rules/"+id, "PATCH", rule)
+	if err != nil {
+		return nil, err
+	}
+	return parseRule(body)
+}
+
+func DeleteRule(ctx context.Context, session *Session, id string) error {
+	_, err := session.Curl(ctx, "rules/"+id, "DELETE")
+	return err
+}
+
+// SetRuleActive toggles the rule through the active-rules endpoints.
+func SetRuleActive(ctx context.Context, session *Session, id string, active bool) error {
+	return toggle(ctx, session, "active-rules", id, active)
+}
+
+func parseRule(body []byte) (*Rule, error) {
+	var resp struct {
+		Data Rule `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse rule: %w", err)
+	}
+	return &resp.Data, nil
+}
@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestRuleActionJSON(t *testing.T) {
+	tests := []struct {
+		action RuleAction
+		json   string
+	}{
+		{RuleAction{Type: "subject", Value: "true"}, `{"type":"subject","value":"true"}`},
+		{RuleAction{Type: "block", Value: "true"}, `{"type":"block","value":true}`},
+		{RuleAction{Type: "encryption", Value: "false"}, `{"type":"encryption","value":false}`},
+	}
+
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.action)
+		if err != nil {
+			t.Fatalf("Marshal(%v): %v", tt.action, err)
+		}
+		if string(data) != tt.json {
+			t.Errorf("Marshal(%v) = %s, want %s", tt.action, data, tt.json)
+		}
+
+		var action RuleAction
+		if err := json.Unmarshal(data, &action); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", data, err)
+		}
+		if action != tt.action {
+			t.Errorf("Unmarshal(%s) = %v, want %v", data, action, tt.action)
+		}
+	}
+}
+
+func TestFindRule(t *testing.T) {
+	session := newTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"data": []Rule{
+			{ID: "r1", Name: "Newsletters"},
+			{ID: "r2", Name: "Receipts"},
+			{ID: "r3", Name: "receipts"},
+		}})
+	})
+
+	rule, err := FindRule(context.Background(), session, "newsletters")
+	if err != nil {
+		t.Fatalf("FindRule: %v", err)
+	}
+	if rule.ID != "r1" {
+		t.Errorf("FindRule ID = %s, want r1", rule.ID)
+	}
+
+	for _, name := range []string{"Receipts", "missing"} {
+		if _, err := FindRule(context.Background(), session, name); err == nil {
+			t.Errorf("FindRule(%q) succeeded, want an error", name)
+		}
+	}
+}
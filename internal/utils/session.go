@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Session is an authenticated connection to an Addy API. Everything scoped to
+// one account or server, such as the HTTP connection pool, the rate limiter,
+// the server version and the quota tracker, hangs off the session so it is shared by every
+// resource and data source using the same credentials.
+type Session struct {
+	Client  *http.Client
+	ApiKey  string
+	BaseURL string
+	Limiter *Limiter
+	Version *VersionGate
+	Quota   *Quota
+}
+
+// NewSession creates a client for baseURL, defaulting to the hosted Addy
+// instance, and wraps it with apiKey.
+func NewSession(ctx context.Context, baseURL string, apiKey string) (*Session, error) {
+	if baseURL == "" {
+		baseURL = url
+	}
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
+	client, err := NewClient(ctx, baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Session{
+		Client:  client,
+		ApiKey:  apiKey,
+		BaseURL: baseURL,
+		Limiter: NewLimiter(requestsPerMinute, time.Minute),
+	}
+	s.Version = NewVersionGate(s)
+	s.Quota = NewQuota(s)
+
+	return s, nil
+}
+
+type sessionKey struct {
+	apiKey  string
+	baseURL string
+}
+
+// Sessions caches sessions per credential. The provider-level session is the
+// default; resources and data sources that override the API key or base URL
+// get an extra session that is created once and reused by every other
+// resource sharing the same credentials.
+type Sessions struct {
+	Default *Session
+
+	mu    sync.Mutex
+	cache map[sessionKey]*Session
+}
+
+func NewSessions(def *Session) *Sessions {
+	return &Sessions{
+		Default: def,
+		cache: map[sessionKey]*Session{
+			{apiKey: def.ApiKey, baseURL: def.BaseURL}: def,
+		},
+	}
+}
+
+// Resolve returns the session for the given overrides. Empty values fall
+// back to the provider-level API key and base URL.
+func (s *Sessions) Resolve(ctx context.Context, apiKey string, baseURL string) (*Session, error) {
+	if apiKey == "" {
+		apiKey = s.Default.ApiKey
+	}
+	if baseURL == "" {
+		baseURL = s.Default.BaseURL
+	}
+	key := sessionKey{apiKey: apiKey, baseURL: strings.TrimSuffix(baseURL, "/")}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if session, ok := s.cache[key]; ok {
+		return session, nil
+	}
+
+	session, err := NewSession(ctx, key.baseURL, key.apiKey)
+	if err != nil {
+		return nil, err
+	}
+	s.cache[key] = session
+
+	return session, nil
+}
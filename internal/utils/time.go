@@ -0,0 +1,21 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+)
+
+// timestampLayout is the layout Addy uses for timestamps in API responses.
+const timestampLayout = "2006-01-02 15:04:05"
+
+// ParseTimestamp parses an Addy API timestamp or an RFC 3339 timestamp, as
+// accepted in configuration.
+func ParseTimestamp(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(timestampLayout, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp %q, expected RFC 3339 or %q", value, timestampLayout)
+}
@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Username is an additional username as returned by the usernames endpoints.
+type Username struct {
+	ID               string     `json:"id"`
+	Username         string     `json:"username"`
+	Description      *string    `json:"description"`
+	FromName         *string    `json:"from_name"`
+	AutoCreateRegex  *string    `json:"auto_create_regex"`
+	AliasesCount     int64      `json:"aliases_count"`
+	DefaultRecipient *Recipient `json:"default_recipient"`
+	Active           bool       `json:"active"`
+	CatchAll         bool       `json:"catch_all"`
+	CanLogin         bool       `json:"can_login"`
+	CreatedAt        string     `json:"created_at"`
+	UpdatedAt        string     `json:"updated_at"`
+}
+
+// UsernameUpdate holds the patchable username fields. Nil pointers clear the
+// value on the server.
+type UsernameUpdate struct {
+	Description     *string `json:"description"`
+	FromName        *string `json:"from_name"`
+	AutoCreateRegex *string `json:"auto_create_regex"`
+}
+
+func ListUsernames(ctx context.Context, session *Session) ([]Username, error) {
+	return listAll[Username](ctx, session, "usernames")
+}
+
+// FindUsername looks up a username by ID, or by name through the list endpoint.
+func FindUsername(ctx context.Context, session *Session, key string) (*Username, error) {
+	if IsUUID(key) {
+		return GetUsername(ctx, session, key)
+	}
+
+	usernames, err := ListUsernames(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	for _, username := range usernames {
+		if strings.EqualFold(username.Username, key) {
+			return &username, nil
+		}
+	}
+	return nil, fmt.Errorf("no username with ID or name %q exists in the account", key)
+}
+
+func GetUsername(ctx context.Context, session *Session, id string) (*Username, error) {
+	body, err := session.Curl(ctx, "usernames/"+id, "GET")
+	if err != nil {
+		return nil, err
+	}
+	return parseUsername(body)
+}
+
+func CreateUsername(ctx context.Context, session *Session, username string) (*Username, error) {
+	body, err := session.CurlJSON(ctx, "usernames", "POST", map[string]string{"username": username})
+	if err != nil {
+		return nil, err
+	}
+	return parseUsername(body)
+}
+
+func UpdateUsername(ctx context.Context, session *Session, id string, update UsernameUpdate) (*Username, error) {
+	body, err := session.CurlJSON(ctx, "usernames/"+id, "PATCH", update)
+	if err != nil {
+		return nil, err
+	}
+	return parseUsername(body)
+}
+
+func DeleteUsername(ctx context.Context, session *Session, id string) error {
+	_, err := session.Curl(ctx, "usernames/"+id, "DELETE")
+	return err
+}
+
+// SetUsernameDefaultRecipient sets the recipient that receives the username's
+// mail by default. A nil recipientID reverts to the account default.
+func SetUsernameDefaultRecipient(ctx context.Context, session *Session, id string, recipientID *string) (*Username, error) {
+	body, err := session.CurlJSON(ctx, "usernames/"+id+"/default-recipient", "PATCH", map[string]*string{"default_recipient": recipientID})
+	if err != nil {
+		return nil, err
+	}
+	return parseUsername(body)
+}
+
+// DefaultRecipientID returns the ID of the username's default recipient, or
+// nil if it uses the account default.
+func (u *Username) DefaultRecipientID() *string {
+	if u.DefaultRecipient == nil {
+		return nil
+	}
+	return &u.DefaultRecipient.ID
+}
+
+// SetUsernameActive toggles the username through the active-usernames endpoints.
+func SetUsernameActive(ctx context.Context, session *Session, id string, active bool) error {
+	return toggle(ctx, session, "active-usernames", id, active)
+}
+
+// SetUsernameCatchAll toggles the username through the catch-all-usernames endpoints.
+func SetUsernameCatchAll(ctx context.Context, session *Session, id string, catchAll bool) error {
+	return toggle(ctx, session, "catch-all-usernames", id, catchAll)
+}
+
+// SetUsernameCanLogin toggles the username through the loginable-usernames endpoints.
+func SetUsernameCanLogin(ctx context.Context, session *Session, id string, canLogin bool) error {
+	return toggle(ctx, session, "loginable-usernames", id, canLogin)
+}
+
+func parseUsername(body []byte) (*Username, error) {
+	var resp struct {
+		Data Username `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse username: %w", err)
+	}
+	return &resp.Data, nil
+}
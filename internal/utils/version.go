@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// AppVersion is the version of the Addy server the provider talks to.
+type AppVersion struct {
+	Version string
+	Major   int64
+	Minor   int64
+	Patch   int64
+}
+
+// AtLeast reports whether v is the same as or newer than min.
+func (v AppVersion) AtLeast(min AppVersion) bool {
+	if v.Major != min.Major {
+		return v.Major > min.Major
+	}
+	if v.Minor != min.Minor {
+		return v.Minor > min.Minor
+	}
+	return v.Patch >= min.Patch
+}
+
+// ParseVersion parses a "major.minor.patch" version string. A leading "v" and
+// any pre-release or build suffix are ignored; missing components are zero.
+func ParseVersion(version string) (AppVersion, error) {
+	v := AppVersion{Version: version}
+
+	core := strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if i := strings.IndexAny(core, "-+"); i >= 0 {
+		core = core[:i]
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) > 3 {
+		return v, fmt.Errorf("invalid version %q", version)
+	}
+
+	nums := []*int64{&v.Major, &v.Minor, &v.Patch}
+	for i, part := range parts {
+		n, err := strconv.ParseInt(part, 10, 64)
+		if err != nil || n < 0 {
+			return v, fmt.Errorf("invalid version %q", version)
+		}
+		*nums[i] = n
+	}
+
+	return v, nil
+}
+
+// GetAppVersion fetches and parses the version reported by the app-version endpoint.
+func GetAppVersion(ctx context.Context, session *Session) (*AppVersion, error) {
+	body, err := session.Curl(ctx, "app-version", "GET")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse app version: %w", err)
+	}
+
+	version, err := ParseVersion(resp.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &version, nil
+}
+
+// Feature is an API feature that older self-hosted releases do not support.
+type Feature struct {
+	Name       string
+	MinVersion string
+}
+
+// Features gated on the server version, with the first release shipping them.
+var (
+	FeatureAutoCreateRegex      = Feature{Name: "auto_create_regex", MinVersion: "1.1.0"}
+	FeatureFailedDeliveryResend = Feature{Name: "resending failed deliveries", MinVersion: "1.3.0"}
+)
+
+// VersionGate fetches the server version once per run and checks features
+// against the minimum release that supports them.
+type VersionGate struct {
+	session *Session
+
+	mu      sync.Mutex
+	version *AppVersion
+}
+
+func NewVersionGate(session *Session) *VersionGate {
+	return &VersionGate{
+		session: session,
+	}
+}
+
+// Version returns the server version, fetching it on first use. Only a
+// successful fetch is remembered, so a transient failure is retried by the
+// next caller.
+func (g *VersionGate) Version(ctx context.Context) (*AppVersion, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.version != nil {
+		return g.version, nil
+	}
+
+	version, err := GetAppVersion(ctx, g.session)
+	if err != nil {
+		return nil, err
+	}
+	g.version = version
+	return version, nil
+}
+
+// Require returns an error when the server is older than the release that
+// introduced feature, naming the feature and the release it needs.
+func (g *VersionGate) Require(ctx context.Context, feature Feature) error {
+	required, err := ParseVersion(feature.MinVersion)
+	if err != nil {
+		return err
+	}
+
+	version, err := g.Version(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to determine addy version for %s: %w", feature.Name, err)
+	}
+
+	if !version.AtLeast(required) {
+		return fmt.Errorf("%s requires addy >= %s, but the server reports %s", feature.Name, feature.MinVersion, version.Version)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestVersionGateRetriesFailures(t *testing.T) {
+	requests := 0
+	session := newTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"version":"1.2.0"}`))
+	})
+
+	ctx := context.Background()
+	if _, err := session.Version.Version(ctx); err == nil {
+		t.Fatal("Version succeeded despite a failing server")
+	}
+
+	if err := session.Version.Require(ctx, FeatureAutoCreateRegex); err != nil {
+		t.Fatalf("Require after a transient failure: %v", err)
+	}
+
+	err := session.Version.Require(ctx, FeatureFailedDeliveryResend)
+	if err == nil || !strings.Contains(err.Error(), "requires addy >= 1.3.0, but the server reports 1.2.0") {
+		t.Errorf("Require on an older server = %v", err)
+	}
+
+	if requests != 2 {
+		t.Errorf("app version fetched %d times, want 2", requests)
+	}
+}